@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/analysis"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/generator"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// NewAnalyzeCommand returns the "analyze" command
+func NewAnalyzeCommand(out io.Writer) *cobra.Command {
+	genOpts := &generator.Options{}
+	opts := &analysis.Options{}
+	cmd := &cobra.Command{
+		Use:   "analyze",
+		Short: "Analyze the retention of attendees and their overlap between cities",
+		Run:   RunAnalyze(out, genOpts, opts),
+	}
+
+	addLoadFlags(cmd.PersistentFlags(), genOpts)
+	addAnalyzeFlags(cmd.PersistentFlags(), opts)
+	return cmd
+}
+
+func addAnalyzeFlags(fs *pflag.FlagSet, opts *analysis.Options) {
+	fs.StringVarP((*string)(&opts.Output), "output", "o", string(analysis.OutputFormatMarkdown), "Output format; available options are 'markdown' and 'json'")
+	fs.StringVar((*string)(&opts.Period), "period", string(types.StatsPeriodQuarter), "Length of the cohorts and activity periods; available options are 'year', 'quarter' and 'month'")
+	fs.IntVar(&opts.CoreThreshold, "core-threshold", 3, "Amount of RSVPs that make a member a core attendee")
+	fs.StringVar(&opts.Salt, "salt", "", "Salt for hashing the member IDs. Set it to compare reports; by default a random salt is used")
+}
+
+func RunAnalyze(out io.Writer, genOpts *generator.Options, opts *analysis.Options) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		cfg, _, err := generator.Load(genOpts)
+		if validationErrs, ok := err.(types.ValidationErrors); ok {
+			for _, e := range validationErrs {
+				log.Error(e)
+			}
+			log.Fatalf("Found %d problem(s) in the configuration", len(validationErrs))
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		report, err := analysis.Analyze(cfg, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := analysis.Write(out, report, opts.Output); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
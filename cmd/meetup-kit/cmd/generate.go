@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/cloud-native-nordics/meetup-kit/pkg/generator"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -22,16 +25,44 @@ func NewGenerateCommand() *cobra.Command {
 }
 
 func addGenFlags(fs *pflag.FlagSet, opts *generator.Options) {
+	addLoadFlags(fs, opts)
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "Whether to actually apply the changes or not")
+	fs.BoolVar(&opts.Validate, "validate", false, "Whether to validate the current state of the repo content with the spec")
+	fs.StringVar(&opts.TemplatesDir, "templates-dir", "", "Point to a directory with *.tmpl files overriding the default templates, see 'meetup-kit templates dump'")
+	fs.StringVar(&opts.SiteDir, "site", "", "Render a static HTML site with pages for all meetup groups, meetups, speakers and companies to this directory")
+	fs.StringVar(&opts.SiteThemeDir, "site-theme-dir", "", "Point to a directory with templates and assets overriding the default site theme, see 'meetup-kit templates dump --site'")
+}
+
+// addLoadFlags adds the flags needed for loading the configuration
+func addLoadFlags(fs *pflag.FlagSet, opts *generator.Options) {
 	fs.StringVar(&opts.SpeakersFile, "speakers-file", "speakers.yaml", "Point to the speakers.yaml file")
 	fs.StringVar(&opts.CompaniesFile, "companies-file", "companies.yaml", "Point to the companies.yaml file")
 	fs.StringVar(&opts.RootDir, "meetups-dir", ".", "Point to the directory that has all meetup groups as subfolders, each with a meetup.yaml file")
-	fs.BoolVar(&opts.DryRun, "dry-run", false, "Whether to actually apply the changes or not")
-	fs.BoolVar(&opts.Validate, "validate", false, "Whether to validate the current state of the repo content with the spec")
+	fs.StringVar(&opts.CacheDir, "cache-dir", ".meetup-cache", "Directory to cache the responses from meetup.com in when using --record or --replay")
+	fs.BoolVar(&opts.Record, "record", false, "Whether to store the responses from meetup.com in the cache. Attendance lists of past meetups are only fetched once")
+	fs.BoolVar(&opts.Replay, "replay", false, "Whether to only use the cached responses from meetup.com, without accessing the network")
+	fs.DurationVar(&opts.CacheMaxAge, "cache-max-age", 24*time.Hour, "Age after which cached responses that can change are reported as stale")
+	fs.IntVar(&opts.Concurrency, "concurrency", generator.DefaultConcurrency, "How many meetup groups to fetch from meetup.com in parallel")
+	addMeetupAPIFlags(fs, opts)
+}
+
+// addMeetupAPIFlags adds the flags for accessing the meetup.com GraphQL API
+func addMeetupAPIFlags(fs *pflag.FlagSet, opts *generator.Options) {
+	fs.StringVar(&opts.MeetupCredentialsFile, "meetup-credentials", "", "Point to a YAML file with the meetup.com API credentials. By default they are read from the MEETUP_* environment variables")
+	fs.StringVar(&opts.MeetupAPIURL, "meetup-api-url", generator.DefaultMeetupAPIURL, "URL of the meetup.com GraphQL API, e.g. of a local stand-in server")
+	fs.StringVar(&opts.MeetupTokenURL, "meetup-token-url", generator.DefaultMeetupTokenURL, "URL to exchange a JWT for a meetup.com access token at")
 }
 
 func RunGen(opts *generator.Options) func(cmd *cobra.Command, args []string) {
 	return func(cmd *cobra.Command, args []string) {
-		if err := generator.Generate(opts); err != nil {
+		err := generator.Generate(opts)
+		if validationErrs, ok := err.(types.ValidationErrors); ok {
+			for _, e := range validationErrs {
+				log.Error(e)
+			}
+			log.Fatalf("Found %d problem(s) in the configuration", len(validationErrs))
+		}
+		if err != nil {
 			log.Fatal(err)
 		}
 	}
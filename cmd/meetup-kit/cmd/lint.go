@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/generator"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/lint"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// NewLintCommand returns the "lint" command
+func NewLintCommand(out io.Writer) *cobra.Command {
+	genOpts := &generator.Options{}
+	opts := &lint.Options{}
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Check the meetup configuration for semantic problems",
+		Run:   RunLint(out, genOpts, opts),
+	}
+
+	addLoadFlags(cmd.PersistentFlags(), genOpts)
+	addLintFlags(cmd.PersistentFlags(), opts)
+	return cmd
+}
+
+func addLintFlags(fs *pflag.FlagSet, opts *lint.Options) {
+	fs.StringVar(&opts.ConfigFile, "config", ".meetup-kit-lint.yaml", "Point to the lint configuration file for disabling rules and overriding severities")
+	fs.StringVarP((*string)(&opts.Output), "output", "o", string(lint.OutputFormatText), "Output format; available options are 'text', 'json' and 'github'")
+}
+
+func RunLint(out io.Writer, genOpts *generator.Options, opts *lint.Options) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		opts.CompaniesFile = genOpts.CompaniesFile
+		opts.SpeakersFile = genOpts.SpeakersFile
+		lintCfg, err := lint.LoadConfig(opts.ConfigFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cfg, problems, err := generator.Load(genOpts)
+		if validationErrs, ok := err.(types.ValidationErrors); ok {
+			for _, e := range validationErrs {
+				log.Error(e)
+			}
+			log.Fatalf("Found %d problem(s) in the configuration", len(validationErrs))
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		findings := lint.Lint(cfg, problems, opts, lintCfg)
+		if err := lint.Write(out, findings, opts.Output); err != nil {
+			log.Fatal(err)
+		}
+		if lint.HasErrors(findings) {
+			log.Fatal("Linting failed")
+		}
+	}
+}
@@ -29,6 +29,10 @@ func NewMeetupKitCommand(in io.Reader, out, err io.Writer) *cobra.Command {
 	addGlobalFlags(root.PersistentFlags())
 
 	root.AddCommand(NewGenerateCommand())
+	root.AddCommand(NewLintCommand(out))
+	root.AddCommand(NewAnalyzeCommand(out))
+	root.AddCommand(NewSchemaCommand(out))
+	root.AddCommand(NewTemplatesCommand())
 	root.AddCommand(NewServeCommand())
 	root.AddCommand(versioncmd.NewCmdVersion(os.Stdout))
 	return root
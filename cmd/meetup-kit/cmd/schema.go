@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/schema"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type schemaOptions struct {
+	outputDir string
+}
+
+// NewSchemaCommand returns the "schema" command
+func NewSchemaCommand(out io.Writer) *cobra.Command {
+	opts := &schemaOptions{}
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("schema [%s]", strings.Join(schemaNames(), "|")),
+		Short: "Print the JSON Schemas for the configuration files",
+		Long: `Print the JSON Schemas for meetup.yaml, speakers.yaml and companies.yaml.
+Editors can use these for validation and autocompletion. If no name is given,
+all schemas are written to --output-dir as <name>.schema.json.`,
+		Args: cobra.MaximumNArgs(1),
+		Run:  RunSchema(out, opts),
+	}
+
+	addSchemaFlags(cmd.PersistentFlags(), opts)
+	return cmd
+}
+
+func addSchemaFlags(fs *pflag.FlagSet, opts *schemaOptions) {
+	fs.StringVar(&opts.outputDir, "output-dir", "schemas", "Point to the directory where to write all schemas")
+}
+
+func RunSchema(out io.Writer, opts *schemaOptions) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		if len(args) == 1 {
+			getSchema, ok := schema.Known[args[0]]
+			if !ok {
+				log.Fatalf("Unknown schema %q, expected one of: %s", args[0], strings.Join(schemaNames(), ", "))
+			}
+			b, err := marshalSchema(getSchema())
+			if err != nil {
+				log.Fatal(err)
+			}
+			fmt.Fprint(out, string(b))
+			return
+		}
+
+		if err := os.MkdirAll(opts.outputDir, 0755); err != nil {
+			log.Fatal(err)
+		}
+		for _, name := range schemaNames() {
+			b, err := marshalSchema(schema.Known[name]())
+			if err != nil {
+				log.Fatal(err)
+			}
+			path := filepath.Join(opts.outputDir, name+".schema.json")
+			if err := ioutil.WriteFile(path, b, 0644); err != nil {
+				log.Fatal(err)
+			}
+			log.Infof("Wrote %s", path)
+		}
+	}
+}
+
+func marshalSchema(s *schema.Schema) ([]byte, error) {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func schemaNames() []string {
+	names := make([]string, 0, len(schema.Known))
+	for name := range schema.Known {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"path/filepath"
+	"time"
+
 	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -22,14 +25,31 @@ func NewServeCommand() *cobra.Command {
 
 func addServeFlags(fs *pflag.FlagSet, opts *graphql.Options) {
 	fs.Uint64Var(&opts.Port, "port", 8080, "Application port to use")
-	fs.StringVar(&opts.ConfigPath, "stats-url", "https://raw.githubusercontent.com/cloud-native-nordics/meetups/master/config.json", "Location of the stats file")
+	fs.StringVar(&opts.ConfigPath, "stats-url", "https://raw.githubusercontent.com/cloud-native-nordics/meetups/master/config.json", "Location of the stats file, an URL or a local path. Local files are reloaded when they change")
+	fs.StringVar(&opts.MeetupsDir, "meetups-dir", "", "Serve a local checkout of the meetups repository instead of the stats file, for previewing changes. The YAML files are reloaded when they change")
+	fs.StringVar(&opts.Meetups.SpeakersFile, "speakers-file", "", "Point to the speakers.yaml file when using --meetups-dir (default \"<meetups-dir>/speakers.yaml\")")
+	fs.StringVar(&opts.Meetups.CompaniesFile, "companies-file", "", "Point to the companies.yaml file when using --meetups-dir (default \"<meetups-dir>/companies.yaml\")")
+	fs.StringVar(&opts.Meetups.CacheDir, "cache-dir", ".meetup-cache", "Directory to cache the responses from meetup.com in when using --replay")
+	fs.BoolVar(&opts.Meetups.Replay, "replay", false, "Whether to only use the cached responses from meetup.com when using --meetups-dir, without accessing the network")
+	fs.DurationVar(&opts.Meetups.CacheMaxAge, "cache-max-age", 24*time.Hour, "Age after which cached responses that can change are reported as stale")
+	addMeetupAPIFlags(fs, &opts.Meetups)
+	fs.DurationVar(&opts.ReloadInterval, "reload-interval", 5*time.Minute, "How often to check the stats URL for changes, 0 disables reloading")
 	fs.StringVar(&opts.SlackToken, "slack-token", "", "Slack token to produce invites")
 	fs.StringVar(&opts.SlackURL, "slack-url", "https://cloud-native-nordics.slack.com", "URL to the slack community")
 	fs.StringVar(&opts.SlackName, "slack-community", "Cloud Native Nordics", "Name of the slack community")
+	graphql.AddFlags(fs)
 }
 
 func RunServe(opts *graphql.Options) func(cmd *cobra.Command, args []string) {
 	return func(cmd *cobra.Command, args []string) {
+		if len(opts.MeetupsDir) != 0 {
+			if len(opts.Meetups.SpeakersFile) == 0 {
+				opts.Meetups.SpeakersFile = filepath.Join(opts.MeetupsDir, "speakers.yaml")
+			}
+			if len(opts.Meetups.CompaniesFile) == 0 {
+				opts.Meetups.CompaniesFile = filepath.Join(opts.MeetupsDir, "companies.yaml")
+			}
+		}
 		if err := graphql.Serve(opts); err != nil {
 			log.Fatal(err)
 		}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/generator"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type templatesDumpOptions struct {
+	outputDir string
+	force     bool
+	site      bool
+}
+
+// NewTemplatesCommand returns the "templates" command
+func NewTemplatesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "Manage the templates used for generating the README files",
+	}
+
+	cmd.AddCommand(NewTemplatesDumpCommand())
+	return cmd
+}
+
+// NewTemplatesDumpCommand returns the "templates dump" command
+func NewTemplatesDumpCommand() *cobra.Command {
+	opts := &templatesDumpOptions{}
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Write the default templates to a directory, as a starting point for --templates-dir or --site-theme-dir",
+		Args:  cobra.NoArgs,
+		Run:   RunTemplatesDump(opts),
+	}
+
+	addTemplatesDumpFlags(cmd.PersistentFlags(), opts)
+	return cmd
+}
+
+func addTemplatesDumpFlags(fs *pflag.FlagSet, opts *templatesDumpOptions) {
+	fs.StringVar(&opts.outputDir, "output-dir", "templates", "Point to the directory where to write the templates")
+	fs.BoolVar(&opts.force, "force", false, "Whether to overwrite templates that already exist in the directory")
+	fs.BoolVar(&opts.site, "site", false, "Whether to write the default site theme instead of the README templates")
+}
+
+func RunTemplatesDump(opts *templatesDumpOptions) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		if err := os.MkdirAll(opts.outputDir, 0755); err != nil {
+			log.Fatal(err)
+		}
+		defaults := generator.DefaultTemplates
+		if opts.site {
+			defaults = generator.DefaultSiteTheme
+		}
+		for name, content := range defaults {
+			path := filepath.Join(opts.outputDir, name)
+			if _, err := os.Stat(path); err == nil && !opts.force {
+				log.Warnf("Not overwriting %s, use --force to overwrite it", path)
+				continue
+			}
+			if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+				log.Fatal(err)
+			}
+			log.Infof("Wrote %s", path)
+		}
+	}
+}
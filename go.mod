@@ -4,6 +4,7 @@ go 1.13
 
 require (
 	github.com/99designs/gqlgen v0.10.2
+	github.com/fsnotify/fsnotify v1.4.9
 	github.com/go-chi/chi v4.0.3+incompatible
 	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b
 	github.com/google/uuid v1.1.1
@@ -14,6 +15,9 @@ require (
 	github.com/spf13/cobra v0.0.5
 	github.com/spf13/pflag v1.0.5
 	github.com/vektah/gqlparser v1.2.1
+	golang.org/x/net v0.0.0-20191004110552-13f9640d40b9
+	golang.org/x/sync v0.2.0
+	gopkg.in/yaml.v3 v3.0.1
 	k8s.io/apimachinery v0.17.2
 	sigs.k8s.io/yaml v1.1.0
 )
@@ -0,0 +1,313 @@
+package analysis
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+// churnWindow is how long attendees can stay away before they count as churned
+const churnWindow = 12 // months
+
+type OutputFormat string
+
+const (
+	OutputFormatJSON     OutputFormat = "json"
+	OutputFormatMarkdown OutputFormat = "markdown"
+)
+
+// Options configures the analysis
+type Options struct {
+	// Output is the format to write the report in
+	Output OutputFormat
+	// Period is the length of the cohorts and of the activity periods
+	Period types.StatsPeriod
+	// CoreThreshold is the amount of RSVPs that make a member a core attendee
+	CoreThreshold int
+	// Salt is hashed together with the member IDs. If it is empty, a random salt is used,
+	// and the hashes differ between runs.
+	Salt string
+}
+
+// Report is the result of the analysis. Members are identified by their meetup.com user ID,
+// which is hashed so that the report holds no personal data.
+type Report struct {
+	Period types.StatsPeriod `json:"period"`
+	// Members is the amount of members that RSVPed for any meetup
+	Members uint64 `json:"members"`
+	// MultiCityMembers is the amount of members that RSVPed for meetups in more than one city
+	MultiCityMembers uint64 `json:"multiCityMembers"`
+	// CitiesPerMember counts the members per amount of cities they RSVPed in
+	CitiesPerMember []CityCount `json:"citiesPerMember"`
+	// Activity holds the active, new, returning and churned members per period, the oldest first
+	Activity []PeriodActivity `json:"activity"`
+	// Cohorts groups the members by the period of their first RSVP, the oldest first
+	Cohorts []Cohort `json:"cohorts"`
+	// Cities holds the members per city, sorted by city
+	Cities []City `json:"cities"`
+	// Overlap counts the members shared by two cities, the largest overlap first
+	Overlap []CityOverlap `json:"overlap"`
+	// CoreThreshold is the amount of RSVPs that make a member a core attendee
+	CoreThreshold int `json:"coreThreshold"`
+	// CoreAttendees lists the members with at least CoreThreshold RSVPs, the most active first
+	CoreAttendees []Attendee `json:"coreAttendees"`
+}
+
+type CityCount struct {
+	Cities  int    `json:"cities"`
+	Members uint64 `json:"members"`
+}
+
+type PeriodActivity struct {
+	Period string `json:"period"`
+	Active uint64 `json:"active"`
+	// New members RSVPed for the first time, returning members RSVPed before
+	New       uint64 `json:"new"`
+	Returning uint64 `json:"returning"`
+	// Churned members RSVPed for the last time in the period. It isn't set until 12 months
+	// have passed since the end of the period, as the members could still come back.
+	Churned   *uint64  `json:"churned,omitempty"`
+	ChurnRate *float64 `json:"churnRate,omitempty"`
+}
+
+type Cohort struct {
+	Period string `json:"period"`
+	Size   uint64 `json:"size"`
+	// Retention holds the share of the cohort that RSVPed in each of the following periods
+	Retention []float64 `json:"retention"`
+}
+
+type City struct {
+	City    string `json:"city"`
+	Members uint64 `json:"members"`
+	// MultiCityMembers is the amount of the members that RSVPed in other cities, too
+	MultiCityMembers uint64 `json:"multiCityMembers"`
+	CoreAttendees    uint64 `json:"coreAttendees"`
+}
+
+type CityOverlap struct {
+	Cities  []string `json:"cities"`
+	Members uint64   `json:"members"`
+}
+
+type Attendee struct {
+	// ID is the hash of the meetup.com user ID
+	ID     string   `json:"id"`
+	RSVPs  uint64   `json:"rsvps"`
+	Cities []string `json:"cities"`
+	// First and Last are the periods of the first and last RSVP
+	First string `json:"first"`
+	Last  string `json:"last"`
+}
+
+type attendance struct {
+	date time.Time
+	city string
+}
+
+// Analyze analyzes the RSVPs to the past meetups in cfg
+func Analyze(cfg *types.Config, opts *Options) (*Report, error) {
+	period := opts.Period
+	if period != types.StatsPeriodYear && period != types.StatsPeriodQuarter && period != types.StatsPeriodMonth {
+		return nil, fmt.Errorf("unknown period %q, use %q, %q or %q", period, types.StatsPeriodYear, types.StatsPeriodQuarter, types.StatsPeriodMonth)
+	}
+	salt := opts.Salt
+	if len(salt) == 0 {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		salt = hex.EncodeToString(b)
+	}
+	now := time.Now().UTC()
+
+	// Collect the RSVPs of every member, and when the first meetup took place
+	members := map[uint64][]attendance{}
+	var first time.Time
+	for _, mg := range cfg.MeetupGroups {
+		if mg.AutogenMeetupGroup == nil {
+			continue
+		}
+		for _, m := range mg.Meetups {
+			if m.AutogenMeetup == nil || !m.Date.UTC().Before(now) {
+				continue
+			}
+			date := m.Date.UTC()
+			for userID := range m.RSVPs {
+				members[userID] = append(members[userID], attendance{date: date, city: mg.City})
+			}
+			if len(m.RSVPs) != 0 && (first.IsZero() || date.Before(first)) {
+				first = date
+			}
+		}
+	}
+
+	report := &Report{
+		Period:          period,
+		Members:         uint64(len(members)),
+		CitiesPerMember: []CityCount{},
+		Activity:        []PeriodActivity{},
+		Cohorts:         []Cohort{},
+		Cities:          []City{},
+		Overlap:         []CityOverlap{},
+		CoreThreshold:   opts.CoreThreshold,
+		CoreAttendees:   []Attendee{},
+	}
+	if first.IsZero() {
+		return report, nil
+	}
+
+	starts := []time.Time{}
+	for start := period.Start(first); !start.After(now); start = period.End(start) {
+		starts = append(starts, start)
+	}
+	periodIndex := func(t time.Time) int {
+		return sort.Search(len(starts), func(i int) bool { return starts[i].After(t) }) - 1
+	}
+
+	activity := make([]PeriodActivity, len(starts))
+	churned := make([]uint64, len(starts))
+	cohorts := make([]Cohort, len(starts))
+	// retained[i][k] counts the members of cohort i that RSVPed k periods later
+	retained := make([][]uint64, len(starts))
+	cities := map[string]*City{}
+	citiesPerMember := map[int]uint64{}
+	overlap := map[[2]string]uint64{}
+
+	for userID, attendances := range members {
+		sort.Slice(attendances, func(i, j int) bool { return attendances[i].date.Before(attendances[j].date) })
+
+		// Count the member once per period it was active in
+		active := map[int]bool{}
+		memberCities := map[string]bool{}
+		for _, a := range attendances {
+			active[periodIndex(a.date)] = true
+			memberCities[a.city] = true
+		}
+		firstPeriod := periodIndex(attendances[0].date)
+		lastPeriod := periodIndex(attendances[len(attendances)-1].date)
+		for i := range active {
+			activity[i].Active++
+			if i == firstPeriod {
+				activity[i].New++
+			} else {
+				activity[i].Returning++
+			}
+			if retained[firstPeriod] == nil {
+				retained[firstPeriod] = make([]uint64, len(starts)-firstPeriod)
+			}
+			retained[firstPeriod][i-firstPeriod]++
+		}
+		churned[lastPeriod]++
+
+		cityNames := make([]string, 0, len(memberCities))
+		for city := range memberCities {
+			cityNames = append(cityNames, city)
+		}
+		sort.Strings(cityNames)
+		citiesPerMember[len(cityNames)]++
+		core := len(attendances) >= opts.CoreThreshold
+		for i, city := range cityNames {
+			c, ok := cities[city]
+			if !ok {
+				c = &City{City: city}
+				cities[city] = c
+			}
+			c.Members++
+			if len(cityNames) > 1 {
+				c.MultiCityMembers++
+			}
+			if core {
+				c.CoreAttendees++
+			}
+			for _, other := range cityNames[i+1:] {
+				overlap[[2]string{city, other}]++
+			}
+		}
+		if len(cityNames) > 1 {
+			report.MultiCityMembers++
+		}
+		if core {
+			report.CoreAttendees = append(report.CoreAttendees, Attendee{
+				ID:     hash(salt, userID),
+				RSVPs:  uint64(len(attendances)),
+				Cities: cityNames,
+				First:  period.Name(starts[firstPeriod]),
+				Last:   period.Name(starts[lastPeriod]),
+			})
+		}
+	}
+
+	for i, start := range starts {
+		a := activity[i]
+		a.Period = period.Name(start)
+		// The members could still come back, until the churn window has passed
+		if !period.End(start).AddDate(0, churnWindow, 0).After(now) {
+			c := churned[i]
+			a.Churned = &c
+			if a.Active != 0 {
+				rate := round(float64(c) / float64(a.Active))
+				a.ChurnRate = &rate
+			}
+		}
+		report.Activity = append(report.Activity, a)
+
+		cohorts[i] = Cohort{Period: period.Name(start), Retention: []float64{}}
+		if retained[i] != nil {
+			cohorts[i].Size = retained[i][0]
+			for _, n := range retained[i][1:] {
+				cohorts[i].Retention = append(cohorts[i].Retention, round(float64(n)/float64(cohorts[i].Size)))
+			}
+		}
+		if cohorts[i].Size != 0 {
+			report.Cohorts = append(report.Cohorts, cohorts[i])
+		}
+	}
+
+	for n, count := range citiesPerMember {
+		report.CitiesPerMember = append(report.CitiesPerMember, CityCount{Cities: n, Members: count})
+	}
+	sort.Slice(report.CitiesPerMember, func(i, j int) bool { return report.CitiesPerMember[i].Cities < report.CitiesPerMember[j].Cities })
+	for _, c := range cities {
+		report.Cities = append(report.Cities, *c)
+	}
+	sort.Slice(report.Cities, func(i, j int) bool { return report.Cities[i].City < report.Cities[j].City })
+	for pair, count := range overlap {
+		report.Overlap = append(report.Overlap, CityOverlap{Cities: []string{pair[0], pair[1]}, Members: count})
+	}
+	sort.Slice(report.Overlap, func(i, j int) bool {
+		a, b := report.Overlap[i], report.Overlap[j]
+		if a.Members != b.Members {
+			return a.Members > b.Members
+		}
+		if a.Cities[0] != b.Cities[0] {
+			return a.Cities[0] < b.Cities[0]
+		}
+		return a.Cities[1] < b.Cities[1]
+	})
+	sort.Slice(report.CoreAttendees, func(i, j int) bool {
+		a, b := report.CoreAttendees[i], report.CoreAttendees[j]
+		if a.RSVPs != b.RSVPs {
+			return a.RSVPs > b.RSVPs
+		}
+		return a.ID < b.ID
+	})
+	return report, nil
+}
+
+// hash returns a pseudonym for the member, which can't be traced back to the user ID without the salt
+func hash(salt string, userID uint64) string {
+	sum := sha256.Sum256([]byte(salt + ":" + strconv.FormatUint(userID, 10)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// round rounds to three decimals, to keep the report readable
+func round(f float64) float64 {
+	return float64(int64(f*1000+0.5)) / 1000
+}
@@ -0,0 +1,115 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxRetentionColumns limits the width of the cohort table in Markdown, the JSON has all periods
+const maxRetentionColumns = 8
+
+// Write writes the report to out in the given format
+func Write(out io.Writer, report *Report, format OutputFormat) error {
+	switch format {
+	case OutputFormatMarkdown, "":
+		writeMarkdown(out, report)
+	case OutputFormatJSON:
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(b))
+	default:
+		return fmt.Errorf("invalid output format: %s", format)
+	}
+	return nil
+}
+
+func writeMarkdown(out io.Writer, r *Report) {
+	fmt.Fprintf(out, "# Attendee analysis\n\n")
+	fmt.Fprintf(out, "- Members: %d\n", r.Members)
+	fmt.Fprintf(out, "- Members in more than one city: %d (%s)\n", r.MultiCityMembers, share(r.MultiCityMembers, r.Members))
+	fmt.Fprintf(out, "- Core attendees (at least %d RSVPs): %d (%s)\n", r.CoreThreshold, len(r.CoreAttendees), share(uint64(len(r.CoreAttendees)), r.Members))
+
+	fmt.Fprintf(out, "\n## Activity per %s\n\n", r.Period)
+	fmt.Fprintf(out, "Churned members RSVPed for the last time in the period, and not within %d months after it.\n\n", churnWindow)
+	fmt.Fprintf(out, "| Period | Active | New | Returning | Churned | Churn rate |\n")
+	fmt.Fprintf(out, "|---|---:|---:|---:|---:|---:|\n")
+	for _, a := range r.Activity {
+		churned, rate := "", ""
+		if a.Churned != nil {
+			churned = fmt.Sprint(*a.Churned)
+		}
+		if a.ChurnRate != nil {
+			rate = percent(*a.ChurnRate)
+		}
+		fmt.Fprintf(out, "| %s | %d | %d | %d | %s | %s |\n", a.Period, a.Active, a.New, a.Returning, churned, rate)
+	}
+
+	columns := 0
+	for _, c := range r.Cohorts {
+		if len(c.Retention) > columns {
+			columns = len(c.Retention)
+		}
+	}
+	if columns > maxRetentionColumns {
+		columns = maxRetentionColumns
+	}
+	fmt.Fprintf(out, "\n## Cohort retention\n\n")
+	fmt.Fprintf(out, "The share of the members with their first RSVP in a %s that RSVPed again n %ss later.\n\n", r.Period, r.Period)
+	fmt.Fprintf(out, "| Cohort | Size |")
+	for i := 1; i <= columns; i++ {
+		fmt.Fprintf(out, " +%d |", i)
+	}
+	fmt.Fprintf(out, "\n|---|---:|%s\n", strings.Repeat("---:|", columns))
+	for _, c := range r.Cohorts {
+		fmt.Fprintf(out, "| %s | %d |", c.Period, c.Size)
+		for i := 0; i < columns; i++ {
+			value := ""
+			if i < len(c.Retention) {
+				value = percent(c.Retention[i])
+			}
+			fmt.Fprintf(out, " %s |", value)
+		}
+		fmt.Fprintln(out)
+	}
+
+	fmt.Fprintf(out, "\n## Cities\n\n")
+	fmt.Fprintf(out, "| City | Members | Also in other cities | Core attendees |\n")
+	fmt.Fprintf(out, "|---|---:|---:|---:|\n")
+	for _, c := range r.Cities {
+		fmt.Fprintf(out, "| %s | %d | %d (%s) | %d |\n", c.City, c.Members, c.MultiCityMembers, share(c.MultiCityMembers, c.Members), c.CoreAttendees)
+	}
+	fmt.Fprintf(out, "\n| Cities per member | Members |\n")
+	fmt.Fprintf(out, "|---:|---:|\n")
+	for _, c := range r.CitiesPerMember {
+		fmt.Fprintf(out, "| %d | %d |\n", c.Cities, c.Members)
+	}
+	if len(r.Overlap) != 0 {
+		fmt.Fprintf(out, "\n| Cities | Shared members |\n")
+		fmt.Fprintf(out, "|---|---:|\n")
+		for _, o := range r.Overlap {
+			fmt.Fprintf(out, "| %s | %d |\n", strings.Join(o.Cities, ", "), o.Members)
+		}
+	}
+
+	fmt.Fprintf(out, "\n## Core attendees\n\n")
+	fmt.Fprintf(out, "| Member | RSVPs | Cities | First | Last |\n")
+	fmt.Fprintf(out, "|---|---:|---|---|---|\n")
+	for _, a := range r.CoreAttendees {
+		fmt.Fprintf(out, "| %s | %d | %s | %s | %s |\n", a.ID, a.RSVPs, strings.Join(a.Cities, ", "), a.First, a.Last)
+	}
+}
+
+func share(n, total uint64) string {
+	if total == 0 {
+		return "-"
+	}
+	return percent(float64(n) / float64(total))
+}
+
+func percent(f float64) string {
+	return fmt.Sprintf("%.0f%%", f*100)
+}
@@ -0,0 +1,184 @@
+package generator
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+const (
+	atomFeedFile = "feed.atom"
+	atomNS       = "http://www.w3.org/2005/Atom"
+)
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	NS      string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID      string     `xml:"id"`
+	Title   string     `xml:"title"`
+	Updated string     `xml:"updated"`
+	Author  atomAuthor `xml:"author"`
+	Links   []atomLink `xml:"link"`
+	Summary string     `xml:"summary,omitempty"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// feedHistory knows when the entries of the feeds were first published. Atom
+// readers use the updated timestamp to find new entries, so it must not change
+// when regenerating the feeds.
+type feedHistory struct {
+	updated map[string]string
+	now     time.Time
+}
+
+// loadFeedHistory reads the timestamps of the entries in the feeds generated before
+func loadFeedHistory(rootDir string, cfg *types.Config) (*feedHistory, error) {
+	h := &feedHistory{updated: map[string]string{}, now: time.Now().UTC().Truncate(time.Second)}
+	paths := []string{atomFeedFile}
+	for i := range cfg.MeetupGroups {
+		paths = append(paths, filepath.Join(cfg.MeetupGroups[i].CityLowercase(), atomFeedFile))
+	}
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(filepath.Join(rootDir, path))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		feed := atomFeed{}
+		if err := xml.Unmarshal(b, &feed); err != nil {
+			return nil, fmt.Errorf("couldn't parse feed %s: %v", path, err)
+		}
+		for _, e := range feed.Entries {
+			if _, ok := h.updated[e.ID]; !ok {
+				h.updated[e.ID] = e.Updated
+			}
+		}
+	}
+	return h, nil
+}
+
+// timestamp returns when the entry was first published, or now if it is new
+func (h *feedHistory) timestamp(id string) string {
+	if ts, ok := h.updated[id]; ok {
+		return ts
+	}
+	return h.now.Format(time.RFC3339)
+}
+
+// meetupGroupFeed returns an Atom feed with the meetups of the group, and their published recordings and slides
+func meetupGroupFeed(mg *types.MeetupGroup, h *feedHistory) ([]byte, error) {
+	return marshalFeed(&atomFeed{
+		ID:      fmt.Sprintf("urn:meetup-kit:feed:%s", mg.MeetupID),
+		Title:   mg.Name,
+		Links:   []atomLink{{Href: fmt.Sprintf("https://www.meetup.com/%s", mg.MeetupID)}},
+		Entries: feedEntries(mg, h),
+	})
+}
+
+// allMeetupsFeed returns an Atom feed with the entries of all meetup groups
+func allMeetupsFeed(mgs []types.MeetupGroup, h *feedHistory) ([]byte, error) {
+	entries := []atomEntry{}
+	for i := range mgs {
+		entries = append(entries, feedEntries(&mgs[i], h)...)
+	}
+	return marshalFeed(&atomFeed{
+		ID:      "urn:meetup-kit:feed:all",
+		Title:   "All meetups",
+		Entries: entries,
+	})
+}
+
+// feedEntries returns an entry for every meetup, and separate entries for its recording and slides
+func feedEntries(mg *types.MeetupGroup, h *feedHistory) []atomEntry {
+	entries := []atomEntry{}
+	author := atomAuthor{Name: mg.Name}
+	for _, m := range mg.Meetups {
+		// Meetups without autogenerated data don't exist on the event source (yet)
+		if m.AutogenMeetup == nil {
+			continue
+		}
+		url := meetupURL(*mg, m)
+		id := fmt.Sprintf("urn:meetup-kit:meetup:%d", m.ID)
+		entries = append(entries, atomEntry{
+			ID:      id,
+			Title:   m.Name,
+			Updated: h.timestamp(id),
+			Author:  author,
+			Links:   []atomLink{{Href: url}},
+			Summary: fmt.Sprintf("%s, %s", m.DateTime(), m.Address),
+		})
+		if len(m.Recording) != 0 {
+			recordingID := id + ":recording"
+			entries = append(entries, atomEntry{
+				ID:      recordingID,
+				Title:   fmt.Sprintf("Recording: %s", m.Name),
+				Updated: h.timestamp(recordingID),
+				Author:  author,
+				Links:   []atomLink{{Href: m.Recording}, {Href: url, Rel: "related"}},
+			})
+		}
+		for i, p := range m.Presentations {
+			if len(p.Slides) == 0 {
+				continue
+			}
+			// The position only identifies the slides until the agenda is reordered, prefer the ID
+			slidesID := fmt.Sprintf("%s:slides:%d", id, i)
+			if len(p.ID) != 0 {
+				slidesID = fmt.Sprintf("%s:slides:%s", id, p.ID)
+			}
+			entries = append(entries, atomEntry{
+				ID:      slidesID,
+				Title:   fmt.Sprintf("Slides: %s", p.Title),
+				Updated: h.timestamp(slidesID),
+				Author:  author,
+				Links:   []atomLink{{Href: p.Slides}, {Href: url, Rel: "related"}},
+				Summary: fmt.Sprintf("Presented at %s", m.Name),
+			})
+		}
+	}
+	return entries
+}
+
+func marshalFeed(feed *atomFeed) ([]byte, error) {
+	feed.NS = atomNS
+	// The newest entries first
+	sort.Slice(feed.Entries, func(i, j int) bool {
+		if feed.Entries[i].Updated != feed.Entries[j].Updated {
+			return feed.Entries[i].Updated > feed.Entries[j].Updated
+		}
+		return feed.Entries[i].ID < feed.Entries[j].ID
+	})
+	// The feed was last updated when its newest entry was
+	feed.Updated = time.Time{}.Format(time.RFC3339)
+	if len(feed.Entries) != 0 {
+		feed.Updated = feed.Entries[0].Updated
+	}
+	b, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(b, '\n')...), nil
+}
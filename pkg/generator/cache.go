@@ -0,0 +1,210 @@
+package generator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/httpclient"
+	log "github.com/sirupsen/logrus"
+)
+
+type CacheMode string
+
+var (
+	// CacheModeOff doesn't use the cache at all, everything is fetched from the network
+	CacheModeOff CacheMode = ""
+	// CacheModeRecord fetches from the network and stores the responses in the cache.
+	// Immutable responses (e.g. the attendance lists of past events) are only fetched once.
+	CacheModeRecord CacheMode = "record"
+	// CacheModeReplay only reads responses from the cache, and never uses the network
+	CacheModeReplay CacheMode = "replay"
+)
+
+// ResponseCache stores responses from the meetup.com API on disk, keyed by URL and request body
+type ResponseCache struct {
+	dir    string
+	mode   CacheMode
+	maxAge time.Duration
+
+	mux     sync.Mutex
+	missing []string
+	stale   []string
+}
+
+// cacheEntry is the format of a file in the cache directory
+type cacheEntry struct {
+	URL string `json:"url"`
+	// Request is the body of the request, e.g. a GraphQL query, if any
+	Request   json.RawMessage `json:"request,omitempty"`
+	FetchedAt time.Time       `json:"fetchedAt"`
+	Body      json.RawMessage `json:"body"`
+	// Next is the URL of the next page, if the response is a page of a list
+	Next string `json:"next,omitempty"`
+}
+
+// NewResponseCache returns a cache storing its entries in dir. Mutable entries
+// older than maxAge are reported as stale.
+func NewResponseCache(dir string, mode CacheMode, maxAge time.Duration) *ResponseCache {
+	return &ResponseCache{
+		dir:    dir,
+		mode:   mode,
+		maxAge: maxAge,
+	}
+}
+
+// GetJSON gets the JSON document at url, either from the network or the cache
+// depending on the mode. immutable tells whether the document can change over
+// time; immutable documents are never considered stale. A nil cache always
+// fetches from the network.
+func (c *ResponseCache) GetJSON(url string, immutable bool, v interface{}) error {
+	_, err := c.GetJSONPage(url, immutable, v)
+	return err
+}
+
+// GetJSONPage is like GetJSON for a page of a list, and returns the URL of the next page,
+// or "" if it's the last page. The URL of the next page is cached together with the page.
+func (c *ResponseCache) GetJSONPage(url string, immutable bool, v interface{}) (string, error) {
+	return c.fetch(url, nil, immutable, v, func(v interface{}) (string, error) {
+		return httpclient.Default.GetJSONPage(context.Background(), url, v)
+	})
+}
+
+// FetchJSON is like GetJSON, but fetches the document with the given function, e.g. for
+// POSTing the request to url with credentials. The entries are keyed by url and request.
+func (c *ResponseCache) FetchJSON(url string, request json.RawMessage, immutable bool, v interface{}, fetch func(v interface{}) error) error {
+	_, err := c.fetch(url, request, immutable, v, func(v interface{}) (string, error) {
+		return "", fetch(v)
+	})
+	return err
+}
+
+// fetch implements FetchJSON and GetJSONPage, fetch returns the URL of the next page, if any
+func (c *ResponseCache) fetch(url string, request json.RawMessage, immutable bool, v interface{}, fetch func(v interface{}) (string, error)) (string, error) {
+	if c == nil || c.mode == CacheModeOff {
+		return fetch(v)
+	}
+
+	key := cacheKey(url, request)
+	entry, err := c.read(key)
+	if err != nil {
+		return "", err
+	}
+	isStale := entry != nil && !immutable && time.Since(entry.FetchedAt) > c.maxAge
+
+	switch c.mode {
+	case CacheModeReplay:
+		if entry == nil {
+			c.report(&c.missing, key)
+			return "", fmt.Errorf("no cache entry for %s", key)
+		}
+		if isStale {
+			c.report(&c.stale, key)
+		}
+	case CacheModeRecord:
+		if entry != nil && immutable {
+			break
+		}
+		if entry == nil {
+			c.report(&c.missing, key)
+		} else if isStale {
+			c.report(&c.stale, key)
+		}
+		var body json.RawMessage
+		next, err := fetch(&body)
+		if err != nil {
+			return "", err
+		}
+		entry = &cacheEntry{
+			URL:       url,
+			Request:   request,
+			FetchedAt: time.Now().UTC(),
+			Body:      body,
+			Next:      next,
+		}
+		if err := c.write(key, entry); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unknown cache mode %q", c.mode)
+	}
+
+	if err := json.Unmarshal(entry.Body, v); err != nil {
+		return "", fmt.Errorf("couldn't decode cache entry for %s: %v", key, err)
+	}
+	return entry.Next, nil
+}
+
+// Report logs which cache entries were missing or stale during this run
+func (c *ResponseCache) Report() {
+	if c == nil || c.mode == CacheModeOff {
+		return
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	sort.Strings(c.missing)
+	sort.Strings(c.stale)
+	missingVerb, staleVerb := "fetched", "refreshed"
+	if c.mode == CacheModeReplay {
+		missingVerb, staleVerb = "not available", "used anyway"
+	}
+	for _, key := range c.missing {
+		log.Warnf("Cache entry missing (%s): %s", missingVerb, key)
+	}
+	for _, key := range c.stale {
+		log.Warnf("Cache entry older than %s (%s): %s", c.maxAge, staleVerb, key)
+	}
+	log.Infof("Response cache in %q: %d entries missing, %d entries stale", c.dir, len(c.missing), len(c.stale))
+}
+
+func (c *ResponseCache) report(list *[]string, key string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	*list = append(*list, key)
+}
+
+// cacheKey identifies a response by the URL and the request body. Requests without a body
+// are keyed by the URL only, so the existing entries of GET requests stay valid.
+func cacheKey(url string, request json.RawMessage) string {
+	if len(request) == 0 {
+		return url
+	}
+	return url + " " + string(request)
+}
+
+func (c *ResponseCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// read returns the cache entry for key, or nil if it doesn't exist
+func (c *ResponseCache) read(key string) (*cacheEntry, error) {
+	b, err := ioutil.ReadFile(c.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	entry := &cacheEntry{}
+	if err := json.Unmarshal(b, entry); err != nil {
+		return nil, fmt.Errorf("couldn't decode cache entry for %s: %v", key, err)
+	}
+	return entry, nil
+}
+
+func (c *ResponseCache) write(key string, entry *cacheEntry) error {
+	b, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(c.path(key), b, false)
+}
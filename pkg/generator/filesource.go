@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+// fileSource is an EventSource reading cached JSON files from a local directory.
+// The directory is laid out as follows:
+//
+//	group.json                 general information about the meetup group
+//	events.json                a list of all events, in the types.AutogenMeetup format,
+//	                           with the dates in RFC3339 including the UTC offset
+//	attendance/<event ID>.json a map of user ID to amount of RSVPs, for past events
+type fileSource struct {
+	dir string
+}
+
+var _ EventSource = &fileSource{}
+
+func newFileSource(dir string) *fileSource {
+	return &fileSource{dir: dir}
+}
+
+// fileGroup is the format of group.json
+type fileGroup struct {
+	Photo       string `json:"photo,omitempty"`
+	Name        string `json:"name"`
+	City        string `json:"city"`
+	Country     string `json:"country"`
+	Description string `json:"description"`
+	Members     uint64 `json:"members"`
+}
+
+func (s *fileSource) GetGroup() (*types.AutogenMeetupGroup, error) {
+	g := &fileGroup{}
+	if err := s.readJSON("group.json", g); err != nil {
+		return nil, err
+	}
+	result := &types.AutogenMeetupGroup{
+		Photo:       g.Photo,
+		Name:        g.Name,
+		Description: g.Description,
+		Members:     g.Members,
+	}
+	result.Country, result.City = normalizeLocation(g.Country, g.City)
+	return result, nil
+}
+
+func (s *fileSource) GetEvents(loc *time.Location) ([]types.AutogenMeetup, error) {
+	events := []types.AutogenMeetup{}
+	if err := s.readJSON("events.json", &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (s *fileSource) GetAttendance(eventID uint64) (map[uint64]uint64, error) {
+	rsvps := map[uint64]uint64{}
+	err := s.readJSON(filepath.Join("attendance", fmt.Sprintf("%d.json", eventID)), &rsvps)
+	// Not all events need to have a cached attendance list
+	if os.IsNotExist(err) {
+		return rsvps, nil
+	}
+	return rsvps, err
+}
+
+func (s *fileSource) readJSON(name string, v interface{}) error {
+	path := filepath.Join(s.dir, name)
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("couldn't read %s: %v", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+// Funcs are the helper functions available in all templates
+var Funcs = template.FuncMap{
+	"date":        formatDate,
+	"speakerLink": speakerLink,
+	"companyLink": companyLink,
+	"sponsorLogo": sponsorLogo,
+	"meetupURL":   meetupURL,
+	"lower":       strings.ToLower,
+	"upper":       strings.ToUpper,
+	"join":        strings.Join,
+}
+
+// formatDate formats a types.Time or time.Time in its time zone, e.g. the one of the
+// meetup group, using the given Go layout, e.g. {{ date "2 Jan 2006 15:04 MST" .Date }}
+func formatDate(layout string, t interface{}) (string, error) {
+	switch v := t.(type) {
+	case types.Time:
+		return v.Format(layout), nil
+	case *types.Time:
+		return v.Format(layout), nil
+	case time.Time:
+		return v.Format(layout), nil
+	default:
+		return "", fmt.Errorf("date: unsupported type %T", t)
+	}
+}
+
+// speakerLink returns the name of the speaker, linking to their GitHub profile if set
+func speakerLink(s types.SpeakerRef) string {
+	if s.Speaker == nil {
+		return string(s.RefID())
+	}
+	if len(s.Github) == 0 {
+		return s.Name
+	}
+	return fmt.Sprintf("[%s](https://github.com/%s)", s.Name, s.Github)
+}
+
+// companyLink returns the name of the company, linking to its website if set
+func companyLink(c types.CompanyRef) string {
+	if c.Company == nil {
+		return string(c.RefID())
+	}
+	if len(c.WebsiteURL) == 0 {
+		return c.Name
+	}
+	return fmt.Sprintf("[%s](%s)", c.Name, c.WebsiteURL)
+}
+
+// sponsorLogo returns an HTML image with the logo of the company, with the given
+// width in pixels, linking to its website. The name is used if there is no logo.
+func sponsorLogo(c types.CompanyRef, width int) string {
+	if c.Company == nil || len(c.LogoURL) == 0 {
+		return companyLink(c)
+	}
+	img := fmt.Sprintf(`<img width="%d" alt="%s" src="%s">`, width, html.EscapeString(c.Name), html.EscapeString(c.LogoURL))
+	if len(c.WebsiteURL) == 0 {
+		return img
+	}
+	return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(c.WebsiteURL), img)
+}
+
+// meetupURL returns the link to the meetup on meetup.com
+func meetupURL(mg types.MeetupGroup, m types.Meetup) string {
+	if m.AutogenMeetup == nil {
+		return fmt.Sprintf("https://www.meetup.com/%s", mg.MeetupID)
+	}
+	return fmt.Sprintf("https://www.meetup.com/%s/events/%d", mg.MeetupID, m.ID)
+}
@@ -9,9 +9,13 @@ import (
 	"path/filepath"
 	"sync"
 	"text/template"
+	"time"
 
+	"github.com/cloud-native-nordics/meetup-kit/pkg/schema"
 	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"sigs.k8s.io/yaml"
 )
 
@@ -28,8 +32,34 @@ type Options struct {
 	DryRun bool
 	// Validate controls whether to validate the current state of the repo content with the spec
 	Validate bool
+	// CacheDir points to the directory where responses from meetup.com are cached
+	CacheDir string
+	// Record controls whether to store responses from meetup.com in the cache
+	Record bool
+	// Replay controls whether to only use the cached responses, without network access
+	Replay bool
+	// CacheMaxAge is the age after which cache entries that can change are considered stale
+	CacheMaxAge time.Duration
+	// MeetupCredentialsFile points to a file with the credentials for the meetup.com API,
+	// by default they are read from the environment
+	MeetupCredentialsFile string
+	// MeetupAPIURL is the URL of the meetup.com GraphQL API, e.g. of a local stand-in server
+	MeetupAPIURL string
+	// MeetupTokenURL is the URL to get meetup.com access tokens from
+	MeetupTokenURL string
+	// Concurrency is how many meetup groups are fetched in parallel, DefaultConcurrency if not set
+	Concurrency int
+	// TemplatesDir points to a directory with templates overriding the default ones
+	TemplatesDir string
+	// SiteDir points to the directory to render the HTML site to, if set
+	SiteDir string
+	// SiteThemeDir points to a directory with templates and assets overriding the default site theme
+	SiteThemeDir string
 }
 
+// DefaultConcurrency is how many meetup groups are fetched in parallel by default
+const DefaultConcurrency = 4
+
 var unmarshal = yaml.UnmarshalStrict
 
 // this maps the locations returned from meetup.com to what we want to use here.
@@ -38,45 +68,148 @@ var cityNameExceptions = map[string]string{
 	"Århus": "Aarhus",
 }
 
+// Generate loads the configuration and writes or validates all generated files.
+// Problems with the configuration are returned as types.ValidationErrors.
 func Generate(opts *Options) error {
 	log.Debugf("generate: %v", *opts)
-	cfg, err := load(opts.CompaniesFile, opts.SpeakersFile, opts.RootDir)
+	templates, err := LoadTemplates(opts.TemplatesDir)
+	if err != nil {
+		return err
+	}
+	theme, err := loadSiteTheme(opts.SiteThemeDir)
+	if err != nil {
+		return err
+	}
+	cache, err := newCache(opts)
+	if err != nil {
+		return err
+	}
+	client, err := NewMeetupClient(opts.MeetupAPIURL, opts.MeetupTokenURL, opts.MeetupCredentialsFile, cache)
+	if err != nil {
+		return err
+	}
+	cfg, _, err := load(opts.CompaniesFile, opts.SpeakersFile, opts.RootDir, client, opts.Concurrency, false)
+	cache.Report()
+	client.pages.Report()
 	if err != nil {
 		return err
 	}
 	if err := update(cfg); err != nil {
 		return err
 	}
-	out, err := exec(cfg)
+	history, err := loadFeedHistory(opts.RootDir, cfg)
+	if err != nil {
+		return err
+	}
+	calendars, err := loadCalendarHistory(opts.RootDir, cfg)
+	if err != nil {
+		return err
+	}
+	out, err := exec(cfg, templates, history, calendars)
 	if err != nil {
 		return err
 	}
 	if opts.Validate {
-		return validate(out, opts.RootDir)
+		if err := validate(out, opts.RootDir); err != nil {
+			return err
+		}
+	} else if err := apply(out, opts.RootDir, opts.DryRun); err != nil {
+		return err
 	}
-	return apply(out, opts.RootDir, opts.DryRun)
+	if len(opts.SiteDir) == 0 {
+		return nil
+	}
+	siteOut, err := site(cfg, theme, calendars)
+	if err != nil {
+		return err
+	}
+	if opts.Validate {
+		return validate(siteOut, opts.SiteDir)
+	}
+	return apply(siteOut, opts.SiteDir, opts.DryRun)
+}
+
+// Load reads the configuration and fetches the autogenerated data like Generate
+// does, but without generating anything. Problems that don't prevent loading,
+// like duplicate IDs or references that can't be resolved, are returned next to
+// the configuration; the affected references are left unresolved.
+func Load(opts *Options) (*types.Config, types.ValidationErrors, error) {
+	log.Debugf("Load: %v", *opts)
+	cache, err := newCache(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := NewMeetupClient(opts.MeetupAPIURL, opts.MeetupTokenURL, opts.MeetupCredentialsFile, cache)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg, problems, err := load(opts.CompaniesFile, opts.SpeakersFile, opts.RootDir, client, opts.Concurrency, true)
+	cache.Report()
+	client.pages.Report()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := update(cfg); err != nil {
+		return nil, nil, err
+	}
+	return cfg, problems, nil
 }
 
-func load(companiesPath, speakersPath, meetupsDir string) (*types.Config, error) {
+func newCache(opts *Options) (*ResponseCache, error) {
+	if opts.Record && opts.Replay {
+		return nil, fmt.Errorf("record and replay can't be used at the same time")
+	}
+	if opts.Record {
+		return NewResponseCache(opts.CacheDir, CacheModeRecord, opts.CacheMaxAge), nil
+	}
+	if opts.Replay {
+		return NewResponseCache(opts.CacheDir, CacheModeReplay, opts.CacheMaxAge), nil
+	}
+	return nil, nil
+}
+
+// load reads the configuration files and fetches the autogenerated data from the
+// event sources, for up to concurrency meetup groups at a time.
+// The files are validated against their JSON Schemas first, which points out
+// where in a file a problem is. All problems with the configuration are
+// returned as types.ValidationErrors, before anything is fetched.
+// If lenient is true, problems with the references between the files are
+// returned next to the configuration instead.
+func load(companiesPath, speakersPath, meetupsDir string, client *MeetupClient, concurrency int, lenient bool) (*types.Config, types.ValidationErrors, error) {
 	log.Debugf("load: %s %s %s", companiesPath, speakersPath, meetupsDir)
+	validationErrs := types.ValidationErrors{}
+	// whether references can be resolved; they can't if the companies or speakers couldn't be parsed
+	resolveRefs := true
+
 	companies := []types.Company{}
 	companiesContent, err := ioutil.ReadFile(companiesPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	log.Debugf("%s", string(companiesContent))
-	if err := unmarshal(companiesContent, &companies); err != nil {
-		return nil, err
+	if errs := schema.Validate(companiesPath, companiesContent, schema.Companies()); len(errs) != 0 {
+		validationErrs = append(validationErrs, errs...)
+		resolveRefs = false
+	} else if err := unmarshal(companiesContent, &companies); err != nil {
+		validationErrs = append(validationErrs, parseError(companiesPath, err))
+		resolveRefs = false
 	}
+
 	speakers := []types.Speaker{}
 	speakersContent, err := ioutil.ReadFile(speakersPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	if err := unmarshal(speakersContent, &speakers); err != nil {
-		return nil, err
+	if errs := schema.Validate(speakersPath, speakersContent, schema.Speakers()); len(errs) != 0 {
+		validationErrs = append(validationErrs, errs...)
+		resolveRefs = false
+	} else if err := unmarshal(speakersContent, &speakers); err != nil {
+		validationErrs = append(validationErrs, parseError(speakersPath, err))
+		resolveRefs = false
 	}
+
 	meetupGroups := []types.MeetupGroup{}
+	sources := []EventSource{}
 
 	err = filepath.Walk(meetupsDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -86,9 +219,12 @@ func load(companiesPath, speakersPath, meetupsDir string) (*types.Config, error)
 			return nil
 		}
 		// Consider only subdirectories of the root path
-		if filepath.Dir(path) != "." {
+		if filepath.Clean(path) == filepath.Clean(meetupsDir) {
 			return nil
 		}
+		if filepath.Dir(path) != filepath.Clean(meetupsDir) {
+			return filepath.SkipDir
+		}
 		meetupsFile := filepath.Join(path, "meetup.yaml")
 		if _, err := os.Stat(meetupsFile); os.IsNotExist(err) {
 			return nil
@@ -100,35 +236,97 @@ func load(companiesPath, speakersPath, meetupsDir string) (*types.Config, error)
 		if err != nil {
 			return err
 		}
+		if errs := schema.Validate(meetupsFile, mgContent, schema.MeetupGroup()); len(errs) != 0 {
+			validationErrs = append(validationErrs, errs...)
+			return nil
+		}
 		if err := unmarshal(mgContent, &mg); err != nil {
-			return err
+			validationErrs = append(validationErrs, parseError(meetupsFile, err))
+			return nil
 		}
+		if err := loadTimeZone(&mg); err != nil {
+			validationErrs = append(validationErrs, types.ValidationError{File: meetupsFile, Path: "timeZone", Kind: types.ValidationErrorInvalidTimeZone, Message: err.Error()})
+			return nil
+		}
+		src, err := NewEventSource(&mg, path, client)
+		if err != nil {
+			validationErrs = append(validationErrs, types.ValidationError{File: meetupsFile, Path: "source", Kind: types.ValidationErrorInvalidSource, Message: err.Error()})
+			return nil
+		}
+		mg.File = meetupsFile
 		meetupGroups = append(meetupGroups, mg)
+		sources = append(sources, src)
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// Now that all files are parsed, resolve the references between them
+	registry := types.NewRegistry()
+	refErrs := types.ValidationErrors{}
+	refErrs = append(refErrs, registry.AddCompanies(companiesPath, companies)...)
+	refErrs = append(refErrs, registry.AddSpeakers(speakersPath, speakers)...)
+	if resolveRefs {
+		refErrs = append(refErrs, registry.ResolveSpeakers(speakersPath, speakers)...)
+		for i := range meetupGroups {
+			refErrs = append(refErrs, registry.ResolveMeetupGroup(meetupGroups[i].File, &meetupGroups[i])...)
+		}
+	}
+	if len(validationErrs) != 0 || (len(refErrs) != 0 && !lenient) {
+		return nil, nil, append(validationErrs, refErrs...)
+	}
+
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
 	}
-	var wg sync.WaitGroup
-	wg.Add(len(meetupGroups))
-	// Run the fetching from the meetup API in parallel for all meetup groups to speed things up
+	g := &errgroup.Group{}
+	g.SetLimit(concurrency)
+	mux := &sync.Mutex{}
+	fetchErrs := []error{}
+	// Fetch from the event sources of a few meetup groups in parallel to speed things up, without
+	// sending so many requests at once that meetup.com throttles them. The errors of all meetup
+	// groups are collected, instead of stopping at the first one.
 	for i := range meetupGroups {
-		go func(mg *types.MeetupGroup) {
-			defer wg.Done()
-			mg.AutogenMeetupGroup, err = GetMeetupInfoFromAPI(*mg)
+		mg, src := &meetupGroups[i], sources[i]
+		g.Go(func() error {
+			autogen, err := GetMeetupInfo(src, *mg)
 			if err != nil {
-				log.Fatal(err)
+				mux.Lock()
+				defer mux.Unlock()
+				fetchErrs = append(fetchErrs, fmt.Errorf("meetup group %q: %v", mg.MeetupID, err))
+				return nil
 			}
+			mg.AutogenMeetupGroup = autogen
 			mg.ApplyGeneratedData()
-		}(&meetupGroups[i])
+			logUnmatched(mg)
+			return nil
+		})
+	}
+	g.Wait()
+	if err := utilerrors.NewAggregate(fetchErrs); err != nil {
+		return nil, nil, err
 	}
-	wg.Wait()
 
 	return &types.Config{
 		Speakers:     speakers,
 		Companies:    companies,
 		MeetupGroups: meetupGroups,
-	}, nil
+	}, refErrs, nil
+}
+
+// logUnmatched warns about the meetups in meetup.yaml and the fetched events that couldn't be matched
+func logUnmatched(mg *types.MeetupGroup) {
+	for _, m := range mg.Unmatched.Meetups {
+		log.Warnf("%s: meetup %q doesn't match any event: %s", mg.File, m.Key, m.Reason)
+	}
+	for _, ev := range mg.Unmatched.Events {
+		log.Warnf("%s: event %d %q on %s isn't in meetups, add it with eventID: %d or ignore it with ignoreEvents", mg.File, ev.ID, ev.Name, ev.Date.YYYYMMDD(), ev.ID)
+	}
+}
+
+func parseError(file string, err error) types.ValidationError {
+	return types.ValidationError{File: file, Kind: types.ValidationErrorParse, Message: err.Error()}
 }
 
 func apply(files map[string][]byte, rootDir string, dryRun bool) error {
@@ -143,7 +341,7 @@ func apply(files map[string][]byte, rootDir string, dryRun bool) error {
 }
 
 func validate(files map[string][]byte, rootDir string) error {
-	log.Debugf("validate: %v %s %t", files, rootDir)
+	log.Debugf("validate: %v %s", files, rootDir)
 	for path, fileContent := range files {
 		fullPath := filepath.Join(rootDir, path)
 		actual, err := ioutil.ReadFile(fullPath)
@@ -167,19 +365,25 @@ func tmpl(t *template.Template, obj interface{}) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func exec(cfg *types.Config) (map[string][]byte, error) {
+func exec(cfg *types.Config, templates *template.Template, history *feedHistory, calendars *calendarHistory) (map[string][]byte, error) {
 	log.Debugf("exec: %v", *cfg)
 	result := map[string][]byte{}
 	types.ShouldMarshalAutoMeetup = false
 	for _, mg := range cfg.MeetupGroups {
 		mg.SetMeetupList()
-		b, err := tmpl(readmeTmpl, mg)
+		b, err := tmpl(templates.Lookup(GroupReadmeTemplate), mg)
 		if err != nil {
 			return nil, err
 		}
 		city := mg.CityLowercase()
 		path := filepath.Join(city, "README.md")
 		result[path] = b
+		result[filepath.Join(city, meetupsICSFile)] = meetupGroupICS(&mg, calendars)
+		feed, err := meetupGroupFeed(&mg, history)
+		if err != nil {
+			return nil, err
+		}
+		result[filepath.Join(city, atomFeedFile)] = feed
 
 		path = filepath.Join(city, "meetup.yaml")
 		mg.AutogenMeetupGroup = nil
@@ -199,18 +403,41 @@ func exec(cfg *types.Config) (map[string][]byte, error) {
 		return nil, err
 	}
 	result["speakers.yaml"] = speakersYAML
-	readmeBytes, err := tmpl(toplevelTmpl, cfg)
+	readmeBytes, err := tmpl(templates.Lookup(ReadmeTemplate), cfg)
 	if err != nil {
 		return nil, err
 	}
 	result["README.md"] = readmeBytes
+	graph := NewGraph(cfg)
+	for i := range cfg.Speakers {
+		s := &cfg.Speakers[i]
+		b, err := tmpl(templates.Lookup(SpeakerTemplate), &ProfileData{Speaker: s, Graph: graph})
+		if err != nil {
+			return nil, err
+		}
+		result[filepath.Join("speakers", string(s.ID)+".md")] = b
+	}
+	for i := range cfg.Companies {
+		c := &cfg.Companies[i]
+		b, err := tmpl(templates.Lookup(CompanyTemplate), &ProfileData{Company: c, Graph: graph})
+		if err != nil {
+			return nil, err
+		}
+		result[filepath.Join("companies", string(c.ID)+".md")] = b
+	}
+	result[allMeetupsICSFile] = allMeetupsICS(cfg.MeetupGroups, calendars)
+	feed, err := allMeetupsFeed(cfg.MeetupGroups, history)
+	if err != nil {
+		return nil, err
+	}
+	result[atomFeedFile] = feed
 	types.ShouldMarshalAutoMeetup = true
 	configJSON, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
 		return nil, err
 	}
 	result["types.Config.json"] = configJSON
-	stats, err := aggregateStats(cfg)
+	stats, err := AggregateStats(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -248,14 +475,15 @@ func calcSponsorTiers(mg *types.MeetupGroup) {
 	for _, m := range mg.Meetups {
 		for _, p := range m.Presentations {
 			for _, s := range p.Speakers {
-				if s.Company.Company != nil {
+				if s.Speaker != nil && s.Company.Company != nil {
 					mg.SponsorTiers[s.Company.ID] = types.SponsorTierSpeakerProvider
 				}
 			}
 		}
 	}
 	for _, o := range mg.Organizers {
-		if o.Company.Company != nil {
+		// Organizers missing in speakers.yaml are left unresolved when loading leniently
+		if o.Speaker != nil && o.Company.Company != nil {
 			mg.SponsorTiers[o.Company.ID] = types.SponsorTierMeetup
 		}
 	}
@@ -0,0 +1,160 @@
+package generator
+
+import (
+	"sort"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+// MeetupRef is a meetup together with the group organizing it
+type MeetupRef struct {
+	types.Meetup
+	Group *types.MeetupGroup
+	// Key is the key of the meetup in the meetups of the group, i.e. its date as YYYYMMDD,
+	// optionally followed by a suffix for several meetups on a day, e.g. 20190312-workshop
+	Key string
+}
+
+// Talk is a presentation given at a meetup
+type Talk struct {
+	Meetup       MeetupRef
+	Presentation types.Presentation
+}
+
+// Sponsorship is a meetup sponsored by a company
+type Sponsorship struct {
+	Meetup MeetupRef
+	Role   types.SponsorRole
+}
+
+// GroupSponsorTier is the sponsor tier of a company in a meetup group
+type GroupSponsorTier struct {
+	Group *types.MeetupGroup
+	Tier  types.SponsorTier
+}
+
+// Graph indexes the relations between meetup groups, meetups, speakers and
+// companies, e.g. to find all talks of a speaker across all cities
+type Graph struct {
+	// Meetups holds the meetups of all groups, the latest first
+	Meetups []MeetupRef
+
+	talks        map[types.SpeakerID][]Talk
+	organizing   map[types.SpeakerID][]*types.MeetupGroup
+	sponsorships map[types.CompanyID][]Sponsorship
+	employees    map[types.CompanyID][]*types.Speaker
+	ecosystem    map[types.CompanyID][]*types.MeetupGroup
+	tiers        map[types.CompanyID][]GroupSponsorTier
+}
+
+// NewGraph indexes the given configuration. The references in it must be resolved.
+func NewGraph(cfg *types.Config) *Graph {
+	g := &Graph{
+		talks:        map[types.SpeakerID][]Talk{},
+		organizing:   map[types.SpeakerID][]*types.MeetupGroup{},
+		sponsorships: map[types.CompanyID][]Sponsorship{},
+		employees:    map[types.CompanyID][]*types.Speaker{},
+		ecosystem:    map[types.CompanyID][]*types.MeetupGroup{},
+		tiers:        map[types.CompanyID][]GroupSponsorTier{},
+	}
+	for i := range cfg.Speakers {
+		s := &cfg.Speakers[i]
+		if s.Company.Company != nil {
+			g.employees[s.Company.ID] = append(g.employees[s.Company.ID], s)
+		}
+	}
+	for i := range cfg.MeetupGroups {
+		mg := &cfg.MeetupGroups[i]
+		for _, o := range mg.Organizers {
+			if o.Speaker != nil {
+				g.organizing[o.ID] = append(g.organizing[o.ID], mg)
+			}
+		}
+		for _, c := range mg.EcosystemMembers {
+			if c.Company != nil {
+				g.ecosystem[c.ID] = append(g.ecosystem[c.ID], mg)
+			}
+		}
+		if mg.AutogenMeetupGroup != nil {
+			for id, tier := range mg.SponsorTiers {
+				g.tiers[id] = append(g.tiers[id], GroupSponsorTier{Group: mg, Tier: tier})
+			}
+		}
+		for key, m := range mg.Meetups {
+			g.Meetups = append(g.Meetups, MeetupRef{Meetup: m, Group: mg, Key: key})
+		}
+	}
+	sort.Slice(g.Meetups, func(i, j int) bool {
+		if g.Meetups[i].Key != g.Meetups[j].Key {
+			return g.Meetups[i].Key > g.Meetups[j].Key
+		}
+		return g.Meetups[i].Group.MeetupID < g.Meetups[j].Group.MeetupID
+	})
+
+	// Iterate the sorted meetups, so that the talks and sponsorships are sorted as well
+	for _, m := range g.Meetups {
+		for _, p := range m.Presentations {
+			for _, s := range p.Speakers {
+				if s.Speaker != nil {
+					g.talks[s.ID] = append(g.talks[s.ID], Talk{Meetup: m, Presentation: p})
+				}
+			}
+		}
+		for _, s := range m.Sponsors {
+			if s.Company.Company != nil {
+				g.sponsorships[s.Company.ID] = append(g.sponsorships[s.Company.ID], Sponsorship{Meetup: m, Role: s.Role})
+			}
+		}
+	}
+	return g
+}
+
+// MeetupsOf returns the meetups of the group, the latest first
+func (g *Graph) MeetupsOf(mg *types.MeetupGroup) []MeetupRef {
+	meetups := []MeetupRef{}
+	for _, m := range g.Meetups {
+		if m.Group == mg {
+			meetups = append(meetups, m)
+		}
+	}
+	return meetups
+}
+
+// Talks returns the talks given by the speaker, the latest first
+func (g *Graph) Talks(id types.SpeakerID) []Talk {
+	return g.talks[id]
+}
+
+// Organizing returns the meetup groups the speaker organizes
+func (g *Graph) Organizing(id types.SpeakerID) []*types.MeetupGroup {
+	return g.organizing[id]
+}
+
+// Sponsorships returns the meetups sponsored by the company, the latest first
+func (g *Graph) Sponsorships(id types.CompanyID) []Sponsorship {
+	return g.sponsorships[id]
+}
+
+// SponsorshipsByRole returns the meetups sponsored by the company grouped by the role of the company
+func (g *Graph) SponsorshipsByRole(id types.CompanyID) map[types.SponsorRole][]Sponsorship {
+	result := map[types.SponsorRole][]Sponsorship{}
+	for _, s := range g.sponsorships[id] {
+		result[s.Role] = append(result[s.Role], s)
+	}
+	return result
+}
+
+// SponsorTiers returns the sponsor tier of the company in every meetup group it sponsors
+func (g *Graph) SponsorTiers(id types.CompanyID) []GroupSponsorTier {
+	return g.tiers[id]
+}
+
+// Employees returns the speakers working for the company
+func (g *Graph) Employees(id types.CompanyID) []*types.Speaker {
+	return g.employees[id]
+}
+
+// EcosystemMemberOf returns the meetup groups the company is an ecosystem member of
+func (g *Graph) EcosystemMemberOf(id types.CompanyID) []*types.MeetupGroup {
+	return g.ecosystem[id]
+}
@@ -0,0 +1,208 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+const (
+	icsTimeFormat     = "20060102T150405Z"
+	allMeetupsICSFile = "all-meetups.ics"
+	meetupsICSFile    = "meetups.ics"
+)
+
+// icsEvent is a meetup together with the group organizing it
+type icsEvent struct {
+	group  *types.MeetupGroup
+	meetup types.Meetup
+}
+
+// calendarHistory knows the events of the calendars generated before. The DTSTAMP of an event is
+// when it last changed, so it must not change when regenerating the calendars without changes.
+type calendarHistory struct {
+	// events maps the UID of an event to its DTSTAMP and its other lines
+	events map[string]icsStampedEvent
+	now    time.Time
+}
+
+type icsStampedEvent struct {
+	stamp   string
+	content string
+}
+
+// loadCalendarHistory reads the events of the calendars generated before
+func loadCalendarHistory(rootDir string, cfg *types.Config) (*calendarHistory, error) {
+	h := &calendarHistory{events: map[string]icsStampedEvent{}, now: time.Now().UTC()}
+	paths := []string{allMeetupsICSFile}
+	for i := range cfg.MeetupGroups {
+		paths = append(paths, filepath.Join(cfg.MeetupGroups[i].CityLowercase(), meetupsICSFile))
+	}
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(filepath.Join(rootDir, path))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		// The calendars were written by calendar, so the events can be compared line by line
+		uid, stamp, content := "", "", ""
+		for _, line := range strings.SplitAfter(string(b), "\r\n") {
+			switch {
+			case line == "BEGIN:VEVENT\r\n":
+				uid, stamp, content = "", "", ""
+			case strings.HasPrefix(line, "UID:"):
+				uid = strings.TrimSuffix(strings.TrimPrefix(line, "UID:"), "\r\n")
+			case strings.HasPrefix(line, "DTSTAMP:"):
+				stamp = strings.TrimSuffix(strings.TrimPrefix(line, "DTSTAMP:"), "\r\n")
+				continue
+			}
+			content += line
+			if _, ok := h.events[uid]; !ok && line == "END:VEVENT\r\n" && len(stamp) != 0 {
+				h.events[uid] = icsStampedEvent{stamp: stamp, content: content}
+			}
+		}
+	}
+	return h, nil
+}
+
+// stamp returns the DTSTAMP of the event with the given lines except DTSTAMP: the DTSTAMP it was
+// generated with before if it is unchanged, or else now
+func (h *calendarHistory) stamp(uid, content string) string {
+	now := h.now.Format(icsTimeFormat)
+	// A stamp in the future isn't a modification time, earlier versions used the start of the event
+	if e, ok := h.events[uid]; ok && e.content == content && e.stamp <= now {
+		return e.stamp
+	}
+	return now
+}
+
+// meetupGroupICS returns an iCalendar file (RFC 5545) with all meetups of the group
+func meetupGroupICS(mg *types.MeetupGroup, h *calendarHistory) []byte {
+	events := []icsEvent{}
+	for _, m := range mg.Meetups {
+		events = append(events, icsEvent{mg, m})
+	}
+	return calendar(mg.Name, events, h)
+}
+
+// allMeetupsICS returns an iCalendar file with the meetups of all groups
+func allMeetupsICS(mgs []types.MeetupGroup, h *calendarHistory) []byte {
+	events := []icsEvent{}
+	for i := range mgs {
+		for _, m := range mgs[i].Meetups {
+			events = append(events, icsEvent{&mgs[i], m})
+		}
+	}
+	return calendar("All meetups", events, h)
+}
+
+func calendar(name string, events []icsEvent, h *calendarHistory) []byte {
+	// Skip meetups without autogenerated data, they don't have a date
+	filtered := events[:0]
+	for _, e := range events {
+		if e.meetup.AutogenMeetup != nil && !e.meetup.Date.IsZero() {
+			filtered = append(filtered, e)
+		}
+	}
+	events = filtered
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].meetup.Date.Equal(events[j].meetup.Date.Time) {
+			return events[i].meetup.Date.Before(events[j].meetup.Date.Time)
+		}
+		return events[i].meetup.ID < events[j].meetup.ID
+	})
+
+	var buf bytes.Buffer
+	writeICSLine(&buf, "BEGIN", "VCALENDAR")
+	writeICSLine(&buf, "VERSION", "2.0")
+	writeICSLine(&buf, "PRODID", "-//cloud-native-nordics//meetup-kit//EN")
+	writeICSLine(&buf, "CALSCALE", "GREGORIAN")
+	writeICSLine(&buf, "METHOD", "PUBLISH")
+	writeICSLine(&buf, "X-WR-CALNAME", escapeICS(name))
+	for _, e := range events {
+		m := e.meetup
+		start := m.Date.UTC()
+		// The UID must stay the same when regenerating, for calendar clients to update the event
+		uid := fmt.Sprintf("meetup-%d@meetup-kit", m.ID)
+		var head, body bytes.Buffer
+		writeICSLine(&head, "BEGIN", "VEVENT")
+		writeICSLine(&head, "UID", uid)
+		writeICSLine(&body, "DTSTART", start.Format(icsTimeFormat))
+		writeICSLine(&body, "DTEND", start.Add(m.Duration.Duration).Format(icsTimeFormat))
+		writeICSLine(&body, "SUMMARY", escapeICS(m.Name))
+		if len(m.Address) != 0 {
+			writeICSLine(&body, "LOCATION", escapeICS(m.Address))
+		}
+		url := meetupURL(*e.group, m)
+		writeICSLine(&body, "URL", url)
+		writeICSLine(&body, "DESCRIPTION", escapeICS(eventDescription(m, url)))
+		writeICSLine(&body, "END", "VEVENT")
+
+		buf.Write(head.Bytes())
+		writeICSLine(&buf, "DTSTAMP", h.stamp(uid, head.String()+body.String()))
+		buf.Write(body.Bytes())
+	}
+	writeICSLine(&buf, "END", "VCALENDAR")
+	return buf.Bytes()
+}
+
+// eventDescription lists the agenda of the meetup, followed by the link to it
+func eventDescription(m types.Meetup, url string) string {
+	lines := []string{}
+	for _, p := range m.Presentations {
+		line := fmt.Sprintf("%s - %s: %s", p.StartTime(), p.EndTime(), p.Title)
+		names := []string{}
+		for _, s := range p.Speakers {
+			if s.Speaker != nil {
+				names = append(names, s.Name)
+			} else {
+				names = append(names, string(s.RefID()))
+			}
+		}
+		if len(names) != 0 {
+			line += fmt.Sprintf(" (%s)", strings.Join(names, ", "))
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 0 {
+		lines = append([]string{"Agenda:"}, lines...)
+		lines = append(lines, "")
+	}
+	lines = append(lines, url)
+	return strings.Join(lines, "\n")
+}
+
+// escapeICS escapes a TEXT value as described in RFC 5545, section 3.3.11
+func escapeICS(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeICSLine writes a content line, folding it after 75 octets as RFC 5545 requires
+func writeICSLine(buf *bytes.Buffer, name, value string) {
+	line := name + ":" + value
+	limit := 75
+	for len(line) > limit {
+		// Don't split multi-byte UTF-8 characters
+		i := limit
+		for i > 0 && !isUTF8Start(line[i]) {
+			i--
+		}
+		buf.WriteString(line[:i] + "\r\n ")
+		line = line[i:]
+		// The leading space of the continuation line counts towards the limit
+		limit = 74
+	}
+	buf.WriteString(line + "\r\n")
+}
+
+func isUTF8Start(b byte) bool {
+	return b&0xC0 != 0x80
+}
@@ -1,54 +1,65 @@
 package generator
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"math"
-	"net/http"
 	"strconv"
-	"strings"
-	"sync"
 	"time"
 
+	"github.com/cloud-native-nordics/meetup-kit/pkg/httpclient"
 	types "github.com/cloud-native-nordics/meetup-kit/pkg/types"
 )
 
 // GetMeetupInfoFromAPI fetches all information it can about the given meetup group
-// from the meetup.com API, and returns the autogenerated type
+// from the meetup.com GraphQL API, using the credentials from the environment, and
+// returns the autogenerated type
 func GetMeetupInfoFromAPI(humanGen types.MeetupGroup) (*types.AutogenMeetupGroup, error) {
-	mg := &meetupGroupAPI{
-		Meetups: []meetupAPI{},
-	}
-	if err := fetchMeetupGroup(humanGen.MeetupID, mg); err != nil {
+	client, err := NewMeetupClient("", "", "", nil)
+	if err != nil {
 		return nil, err
 	}
-	if err := fetchMeetups(humanGen.MeetupID, &mg.Meetups); err != nil {
+	return GetMeetupInfo(newMeetupGraphQLSource(humanGen.MeetupID, client), humanGen)
+}
+
+// meetupDotComSource is an EventSource fetching the information from the legacy meetup.com
+// REST API, which no longer works without authentication
+type meetupDotComSource struct {
+	meetupGroupID string
+	cache         *ResponseCache
+	pages         *pageCounter
+}
+
+var _ EventSource = &meetupDotComSource{}
+
+func newMeetupDotComSource(meetupGroupID string, cache *ResponseCache, pages *pageCounter) *meetupDotComSource {
+	return &meetupDotComSource{meetupGroupID: meetupGroupID, cache: cache, pages: pages}
+}
+
+func (s *meetupDotComSource) GetGroup() (*types.AutogenMeetupGroup, error) {
+	mg := &meetupGroupAPI{}
+	if err := s.fetchMeetupGroup(mg); err != nil {
 		return nil, err
 	}
-	result := &types.AutogenMeetupGroup{
-		AutoMeetups: map[string]types.AutogenMeetup{},
-	}
+	result := &types.AutogenMeetupGroup{}
 	result.Members = mg.Members
 	result.Description = mg.Description
 	result.Photo = mg.Photo.Link
-	result.Country = strings.ToLower(mg.Country)
-	result.City = mg.City
-	if newName, ok := cityNameExceptions[mg.City]; ok {
-		result.City = newName
-	}
+	result.Country, result.City = normalizeLocation(mg.Country, mg.City)
 	result.Name = mg.Name
+	return result, nil
+}
 
-	for _, ev := range mg.Meetups {
-		t, err := ev.GetTime()
+func (s *meetupDotComSource) GetEvents(loc *time.Location) ([]types.AutogenMeetup, error) {
+	events := []meetupAPI{}
+	if err := s.fetchMeetups(&events); err != nil {
+		return nil, err
+	}
+	result := make([]types.AutogenMeetup, 0, len(events))
+	for _, ev := range events {
+		t, err := ev.GetTime(loc)
 		if err != nil {
 			return nil, err
 		}
-		dateStr := t.YYYYMMDD()
-		for _, ignoreDate := range humanGen.IgnoreMeetupDates {
-			if ignoreDate == dateStr {
-				continue
-			}
-		}
 		meetup := types.AutogenMeetup{}
 		id, _ := strconv.Atoi(ev.ID)
 		meetup.ID = uint64(id)
@@ -56,40 +67,40 @@ func GetMeetupInfoFromAPI(humanGen types.MeetupGroup) (*types.AutogenMeetupGroup
 		meetup.Date = *t
 		meetup.Name = ev.Name
 		meetup.Address = ev.Venue.Address
-		meetup.Duration = types.Duration{time.Duration(ev.Duration * 1000 * 1000)}
-
-		if time.Now().UTC().After(meetup.Date.Time) {
-			meetup.Attendees = ev.RVSPs
-			// Only collect RSVP data after the event
-			ev.Attendance = []meetupAttendanceAPI{}
-			if err := fetchAttendanceList(humanGen.MeetupID, meetup.ID, &ev.Attendance); err != nil {
-				return nil, err
-			}
-			meetup.RSVPs = attendanceToRSVPList(ev.Attendance)
-		} else {
-			meetup.Attendees = 0
-		}
-
-		result.AutoMeetups[t.YYYYMMDD()] = meetup
+		meetup.Duration = types.Duration{Duration: time.Duration(ev.Duration * 1000 * 1000)}
+		meetup.Attendees = ev.RVSPs
+		result = append(result, meetup)
 	}
 	return result, nil
 }
 
-func fetchMeetupGroup(meetupGroupID string, mg *meetupGroupAPI) error {
-	url := fmt.Sprintf("https://api.meetup.com/%s", meetupGroupID)
-	return GetJSON(url, mg)
+func (s *meetupDotComSource) GetAttendance(eventID uint64) (map[uint64]uint64, error) {
+	attendance := []meetupAttendanceAPI{}
+	if err := s.fetchAttendanceList(eventID, &attendance); err != nil {
+		return nil, err
+	}
+	return attendanceToRSVPList(attendance), nil
 }
 
-func fetchMeetups(meetupGroupID string, meetups *[]meetupAPI) error {
-	url := fmt.Sprintf("https://api.meetup.com/%s/events?sign=true&photo-host=public&page=100&status=past,upcoming&fields=featured_photo", meetupGroupID)
-	return GetJSON(url, meetups)
+func (s *meetupDotComSource) fetchMeetupGroup(mg *meetupGroupAPI) error {
+	url := fmt.Sprintf("https://api.meetup.com/%s", s.meetupGroupID)
+	return s.cache.GetJSON(url, false, mg)
 }
 
-func fetchAttendanceList(meetupGroupID string, meetupID uint64, att *[]meetupAttendanceAPI) error {
-	url := fmt.Sprintf("https://api.meetup.com/%s/events/%d/attendance?&sign=true&photo-host=public&page=20", meetupGroupID, meetupID)
-	return GetJSON(url, att)
+func (s *meetupDotComSource) fetchMeetups(meetups *[]meetupAPI) error {
+	url := fmt.Sprintf("https://api.meetup.com/%s/events?sign=true&photo-host=public&page=%d&status=past,upcoming&fields=featured_photo", s.meetupGroupID, restPageSize)
+	return s.getAllPages(fmt.Sprintf("the events of %s", s.meetupGroupID), url, restPageSize, false, meetups)
 }
 
+// fetchAttendanceList fetches the attendance list of a past event, which doesn't change anymore
+func (s *meetupDotComSource) fetchAttendanceList(meetupID uint64, att *[]meetupAttendanceAPI) error {
+	url := fmt.Sprintf("https://api.meetup.com/%s/events/%d/attendance?sign=true&photo-host=public&page=%d", s.meetupGroupID, meetupID, restPageSize)
+	return s.getAllPages(fmt.Sprintf("the attendance of event %d", meetupID), url, restPageSize, true, att)
+}
+
+// restPageSize is the amount of items per page requested from the REST API, which allows up to 200
+const restPageSize = 200
+
 type meetupGroupAPI struct {
 	ID          uint64 `json:"id"`
 	Name        string `json:"name"`
@@ -100,7 +111,6 @@ type meetupGroupAPI struct {
 	Photo       struct {
 		Link string `json:"highres_link"`
 	} `json:"key_photo"`
-	Meetups []meetupAPI `json:"-"`
 }
 
 type meetupAPI struct {
@@ -116,16 +126,21 @@ type meetupAPI struct {
 	Photo struct {
 		Link string `json:"highres_link"`
 	} `json:"featured_photo"`
-	Attendance []meetupAttendanceAPI `json:"-"`
+	// UTCTime is the start of the event in milliseconds since the epoch
+	UTCTime int64 `json:"time"`
 }
 
-func (ev *meetupAPI) GetTime() (*types.Time, error) {
-	dateTime := fmt.Sprintf("%sT%s:00Z", ev.Date, ev.Time)
-	d, err := time.Parse(time.RFC3339, dateTime)
+// GetTime returns the start of the event. The local date and time are in loc, and only
+// used if the response doesn't have the time since the epoch.
+func (ev *meetupAPI) GetTime(loc *time.Location) (*types.Time, error) {
+	if ev.UTCTime != 0 {
+		return &types.Time{Time: time.Unix(0, ev.UTCTime*int64(time.Millisecond)).In(loc)}, nil
+	}
+	d, err := time.ParseInLocation("2006-01-02T15:04", fmt.Sprintf("%sT%s", ev.Date, ev.Time), loc)
 	if err != nil {
 		return nil, err
 	}
-	return &types.Time{d}, nil
+	return &types.Time{Time: d}, nil
 }
 
 type meetupAttendanceAPI struct {
@@ -162,16 +177,10 @@ func attendanceToRSVPList(attendance []meetupAttendanceAPI) map[uint64]uint64 {
 	return rsvpMap
 }
 
+// GetJSON gets the JSON document at url with the shared HTTP client, which retries failed
+// requests and limits the request rate
 func GetJSON(url string, v interface{}) error {
-	resp, err := http.Get(url)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-		return fmt.Errorf("GetJSON failed for url %s with error %v", url, err)
-	}
-	return nil
+	return httpclient.Default.GetJSON(context.Background(), url, v)
 }
 
 func setPresentationTimestamps(m *types.Meetup) error {
@@ -192,90 +201,3 @@ func setPresentationTimestamps(m *types.Meetup) error {
 	}
 	return nil
 }
-
-func aggregateStats(cfg *types.Config) (*types.StatsFile, error) {
-	s := &types.StatsFile{
-		MeetupGroups: uint64(len(cfg.MeetupGroups)),
-		PerMeetup:    map[string]types.MeetupStats{},
-	}
-
-	var wg sync.WaitGroup
-	wg.Add(len(cfg.MeetupGroups))
-	mux := &sync.Mutex{}
-
-	for _, mg := range cfg.MeetupGroups {
-		go func(mg types.MeetupGroup) {
-			defer wg.Done()
-
-			mgStat := types.MeetupStats{
-				SponsorByTier: map[types.SponsorTier]uint64{},
-			}
-			mgStat.Members = mg.Members
-			totalRSVPs := uint64(0)
-			// uniqueRSVPs maps an user ID to the amount of RSVPs for that user
-			uniqueRSVPs := map[uint64]uint64{}
-			speakers := map[string]bool{}
-			priorMeetups := uint64(0)
-			for _, m := range mg.Meetups {
-				if m.Date.UTC().After(time.Now().UTC()) {
-					continue
-				}
-				priorMeetups++
-				totalRSVPs += m.Attendees
-				for _, pres := range m.Presentations {
-					for _, s := range pres.Speakers {
-						speakers[string(s.ID)] = true
-					}
-				}
-
-				if m.RSVPs != nil {
-					for userID, rsvpAmount := range m.RSVPs {
-						rsvps, ok := uniqueRSVPs[userID]
-						if ok {
-							// add the cumulatively the amount of guests (excluding the user, as they have already been counted)
-							uniqueRSVPs[userID] = rsvps + rsvpAmount - 1
-						} else {
-							// the first time, count the user itself, too
-							uniqueRSVPs[userID] = rsvpAmount
-						}
-					}
-				}
-			}
-
-			var sponsors uint64 = 0
-			for _, tier := range mg.SponsorTiers {
-				mgStat.SponsorByTier[tier] += 1
-				if tier != types.SponsorTierEcosystemMember {
-					sponsors++
-				}
-			}
-
-			mgStat.Sponsors = sponsors
-			mgStat.Speakers = uint64(len(speakers))
-			mgStat.TotalRSVPs = totalRSVPs
-			if priorMeetups > 0 {
-				mgStat.Meetups = priorMeetups
-				mgStat.AverageRSVPs = uint64(math.Floor(float64(totalRSVPs / priorMeetups)))
-			}
-			for _, num := range uniqueRSVPs {
-				mgStat.UniqueRSVPs += num
-			}
-
-			// Write to the global state one goroutine at a time
-			mux.Lock()
-			defer mux.Unlock()
-
-			s.PerMeetup[mg.CityLowercase()] = mgStat
-
-			s.AllMeetups.Meetups += mgStat.Meetups
-			s.AllMeetups.Members += mgStat.Members
-			s.AllMeetups.TotalRSVPs += mgStat.TotalRSVPs
-			s.AllMeetups.UniqueRSVPs += mgStat.UniqueRSVPs
-			s.AllMeetups.Speakers += mgStat.Speakers
-			s.AllMeetups.Sponsors += mgStat.Sponsors
-		}(mg)
-	}
-	wg.Wait()
-	s.AllMeetups.AverageRSVPs = uint64(math.Floor(float64(s.AllMeetups.TotalRSVPs / s.AllMeetups.Meetups)))
-	return s, nil
-}
@@ -0,0 +1,197 @@
+package generator
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/httpclient"
+)
+
+// MeetupCredentials authenticate the requests to the meetup.com GraphQL API. Either an access
+// token is given, or the credentials of an OAuth client for the JWT flow, in which a JWT signed
+// with the client's signing key is exchanged for an access token.
+// See https://www.meetup.com/api/authentication/#p04-jwt-flow-section
+type MeetupCredentials struct {
+	// AccessToken is an OAuth access token, used as is
+	AccessToken string `json:"accessToken,omitempty"`
+	// ClientKey is the key of the OAuth client
+	ClientKey string `json:"clientKey,omitempty"`
+	// MemberID is the ID of the member that authorized the OAuth client
+	MemberID string `json:"memberID,omitempty"`
+	// SigningKeyID is the ID of the signing key of the OAuth client
+	SigningKeyID string `json:"signingKeyID,omitempty"`
+	// PrivateKey is the PEM-encoded RSA private key of the signing key
+	PrivateKey string `json:"privateKey,omitempty"`
+	// PrivateKeyFile points to a file with the private key, instead of PrivateKey
+	PrivateKeyFile string `json:"privateKeyFile,omitempty"`
+}
+
+// LoadMeetupCredentials reads the credentials from a YAML or JSON file. If file is empty, they are
+// read from the MEETUP_ACCESS_TOKEN, MEETUP_CLIENT_KEY, MEETUP_MEMBER_ID, MEETUP_SIGNING_KEY_ID,
+// MEETUP_PRIVATE_KEY and MEETUP_PRIVATE_KEY_FILE environment variables instead.
+func LoadMeetupCredentials(file string) (*MeetupCredentials, error) {
+	if len(file) == 0 {
+		return &MeetupCredentials{
+			AccessToken:    os.Getenv("MEETUP_ACCESS_TOKEN"),
+			ClientKey:      os.Getenv("MEETUP_CLIENT_KEY"),
+			MemberID:       os.Getenv("MEETUP_MEMBER_ID"),
+			SigningKeyID:   os.Getenv("MEETUP_SIGNING_KEY_ID"),
+			PrivateKey:     os.Getenv("MEETUP_PRIVATE_KEY"),
+			PrivateKeyFile: os.Getenv("MEETUP_PRIVATE_KEY_FILE"),
+		}, nil
+	}
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	creds := &MeetupCredentials{}
+	if err := unmarshal(b, creds); err != nil {
+		return nil, fmt.Errorf("couldn't read meetup.com credentials from %s: %v", file, err)
+	}
+	return creds, nil
+}
+
+// jwt returns a JWT signed with the private key of the OAuth client, valid for two minutes
+func (c *MeetupCredentials) jwt(now time.Time) (string, error) {
+	if len(c.ClientKey) == 0 || len(c.MemberID) == 0 || len(c.SigningKeyID) == 0 || (len(c.PrivateKey) == 0 && len(c.PrivateKeyFile) == 0) {
+		return "", fmt.Errorf("no meetup.com credentials: set an access token, or the client key, member ID, signing key ID and private key of an OAuth client")
+	}
+	keyPEM := []byte(c.PrivateKey)
+	if len(keyPEM) == 0 {
+		var err error
+		if keyPEM, err = ioutil.ReadFile(c.PrivateKeyFile); err != nil {
+			return "", err
+		}
+	}
+	key, err := parseRSAPrivateKey(keyPEM)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": c.SigningKeyID})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"sub": c.MemberID,
+		"iss": c.ClientKey,
+		"aud": "api.meetup.com",
+		"exp": now.Add(2 * time.Minute).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(payload))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return payload + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func parseRSAPrivateKey(b []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("the meetup.com private key isn't PEM-encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse the meetup.com private key: %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the meetup.com private key isn't an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// defaultTokenLifetime is how long an access token is used if the response doesn't tell when it
+// expires, meetup.com issues tokens that are valid for an hour
+const defaultTokenLifetime = time.Hour
+
+// meetupTokenSource returns access tokens for the credentials. Tokens from the JWT flow are
+// requested when they are first needed, e.g. not at all when replaying cached responses, and
+// reused until shortly before they expire.
+type meetupTokenSource struct {
+	creds    *MeetupCredentials
+	tokenURL string
+
+	mux    sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func newMeetupTokenSource(creds *MeetupCredentials, tokenURL string) *meetupTokenSource {
+	return &meetupTokenSource{creds: creds, tokenURL: tokenURL}
+}
+
+// Token returns a valid access token
+func (ts *meetupTokenSource) Token() (string, error) {
+	if len(ts.creds.AccessToken) != 0 {
+		return ts.creds.AccessToken, nil
+	}
+	ts.mux.Lock()
+	defer ts.mux.Unlock()
+	now := time.Now()
+	if len(ts.token) != 0 && now.Add(time.Minute).Before(ts.expiry) {
+		return ts.token, nil
+	}
+
+	assertion, err := ts.creds.jwt(now)
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequest(http.MethodPost, ts.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := httpclient.Default.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("couldn't get a meetup.com access token: %v", err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	token := struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}{}
+	if err := json.Unmarshal(b, &token); err != nil {
+		return "", fmt.Errorf("couldn't decode the meetup.com access token: %v", err)
+	}
+	if len(token.AccessToken) == 0 {
+		return "", fmt.Errorf("the response of %s doesn't contain a meetup.com access token", ts.tokenURL)
+	}
+	lifetime := time.Duration(token.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+	ts.token = token.AccessToken
+	ts.expiry = now.Add(lifetime)
+	return ts.token, nil
+}
@@ -0,0 +1,445 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/httpclient"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+const (
+	// DefaultMeetupAPIURL is the endpoint of the meetup.com GraphQL API
+	DefaultMeetupAPIURL = "https://api.meetup.com/gql-ext"
+	// DefaultMeetupTokenURL is where a JWT is exchanged for an access token
+	DefaultMeetupTokenURL = "https://secure.meetup.com/oauth2/access"
+)
+
+// MeetupClient sends queries to the meetup.com GraphQL API. The responses are stored in the
+// cache, if it is non-nil, keyed by the query and its variables.
+type MeetupClient struct {
+	url    string
+	tokens *meetupTokenSource
+	cache  *ResponseCache
+	// pages counts the pages of the lists fetched by all sources using the client
+	pages *pageCounter
+}
+
+// NewMeetupClient returns a client for the GraphQL API at apiURL, which uses the credentials from
+// credentialsFile, or the environment if it's empty. The URLs default to the ones of meetup.com, and
+// can point to a local stand-in server, e.g. serving recorded responses.
+func NewMeetupClient(apiURL, tokenURL, credentialsFile string, cache *ResponseCache) (*MeetupClient, error) {
+	if len(apiURL) == 0 {
+		apiURL = DefaultMeetupAPIURL
+	}
+	if len(tokenURL) == 0 {
+		tokenURL = DefaultMeetupTokenURL
+	}
+	creds, err := LoadMeetupCredentials(credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+	return &MeetupClient{
+		url:    apiURL,
+		tokens: newMeetupTokenSource(creds, tokenURL),
+		cache:  cache,
+		pages:  newPageCounter(),
+	}, nil
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// Query runs the GraphQL query with the given variables, and decodes the data of the response
+// into data. immutable tells whether the response can change over time, like for GetJSON.
+func (c *MeetupClient) Query(query string, variables map[string]interface{}, immutable bool, data interface{}) error {
+	// Collapse the whitespace, to keep the cache keys short
+	request, err := json.Marshal(graphQLRequest{Query: strings.Join(strings.Fields(query), " "), Variables: variables})
+	if err != nil {
+		return err
+	}
+	resp := &graphQLResponse{}
+	if err := c.cache.FetchJSON(c.url, request, immutable, resp, func(v interface{}) error {
+		return c.post(request, v)
+	}); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(resp.Data, data); err != nil {
+		return fmt.Errorf("couldn't decode the response of %s: %v", c.url, err)
+	}
+	return nil
+}
+
+// post sends the request, and decodes the response into v if it holds no errors. Responses
+// with errors are never cached.
+func (c *MeetupClient) post(request []byte, v interface{}) error {
+	token, err := c.tokens.Token()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(request))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := httpclient.Default.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	gqlResp := &graphQLResponse{}
+	if err := json.Unmarshal(b, gqlResp); err != nil {
+		return fmt.Errorf("couldn't decode the response of %s: %v", c.url, err)
+	}
+	if len(gqlResp.Errors) != 0 {
+		messages := make([]string, 0, len(gqlResp.Errors))
+		for _, e := range gqlResp.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("%s responded with errors: %s", c.url, strings.Join(messages, "; "))
+	}
+	return json.Unmarshal(b, v)
+}
+
+// meetupGraphQLSource is an EventSource fetching the information from the meetup.com GraphQL API
+type meetupGraphQLSource struct {
+	urlname string
+	client  *MeetupClient
+}
+
+var _ EventSource = &meetupGraphQLSource{}
+
+func newMeetupGraphQLSource(urlname string, client *MeetupClient) *meetupGraphQLSource {
+	return &meetupGraphQLSource{urlname: urlname, client: client}
+}
+
+const groupQuery = `
+query($urlname: String!) {
+  groupByUrlname(urlname: $urlname) {
+    id
+    name
+    description
+    city
+    country
+    keyGroupPhoto { highResUrl }
+    stats { memberCounts { all } }
+  }
+}`
+
+const eventsQuery = `
+query($urlname: String!, $status: EventStatus!, $first: Int!, $after: String) {
+  groupByUrlname(urlname: $urlname) {
+    events(status: $status, first: $first, after: $after) {
+      pageInfo { hasNextPage endCursor }
+      edges {
+        node {
+          id
+          title
+          dateTime
+          duration
+          going
+          venue { address }
+          featuredEventPhoto { highResUrl }
+        }
+      }
+    }
+  }
+}`
+
+const attendanceQuery = `
+query($id: ID!, $first: Int!, $after: String) {
+  event(id: $id) {
+    rsvps(first: $first, after: $after) {
+      pageInfo { hasNextPage endCursor }
+      edges {
+        node {
+          status
+          guestsCount
+          member { id }
+        }
+      }
+    }
+  }
+}`
+
+type meetupGroupGQL struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	City          string `json:"city"`
+	Country       string `json:"country"`
+	KeyGroupPhoto *struct {
+		HighResURL string `json:"highResUrl"`
+	} `json:"keyGroupPhoto"`
+	Stats struct {
+		MemberCounts struct {
+			All uint64 `json:"all"`
+		} `json:"memberCounts"`
+	} `json:"stats"`
+}
+
+type meetupEventGQL struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	DateTime string `json:"dateTime"`
+	Duration string `json:"duration"`
+	Going    uint64 `json:"going"`
+	Venue    *struct {
+		Address string `json:"address"`
+	} `json:"venue"`
+	FeaturedEventPhoto *struct {
+		HighResURL string `json:"highResUrl"`
+	} `json:"featuredEventPhoto"`
+}
+
+// gqlPageSize is the amount of items per page requested from the GraphQL API
+const gqlPageSize = 100
+
+// pageInfoGQL tells whether there are more pages of a connection, and where the next one starts
+type pageInfoGQL struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// next returns the cursor of the next page, or "" if it was the last page of the list
+func (p pageInfoGQL) next(list string, pages int) (string, error) {
+	if !p.HasNextPage {
+		return "", nil
+	}
+	if len(p.EndCursor) == 0 {
+		return "", fmt.Errorf("%s has more pages, but no cursor for them", list)
+	}
+	if pages == maxPages {
+		return "", fmt.Errorf("%s has more than %d pages", list, maxPages)
+	}
+	return p.EndCursor, nil
+}
+
+// pageVariables returns the variables of a query for the page starting after cursor. The first
+// page has no cursor, keeping the cache keys of lists with a single page short.
+func pageVariables(vars map[string]interface{}, cursor string) map[string]interface{} {
+	vars["first"] = gqlPageSize
+	if len(cursor) != 0 {
+		vars["after"] = cursor
+	}
+	return vars
+}
+
+type meetupRSVPGQL struct {
+	Status      string `json:"status"`
+	GuestsCount uint64 `json:"guestsCount"`
+	Member      struct {
+		ID string `json:"id"`
+	} `json:"member"`
+}
+
+// countryNames maps the ISO country codes, as the GraphQL API returns them, to the names the REST API returned
+var countryNames = map[string]string{
+	"dk": "denmark",
+	"ee": "estonia",
+	"fi": "finland",
+	"fo": "faroe islands",
+	"gl": "greenland",
+	"is": "iceland",
+	"lt": "lithuania",
+	"lv": "latvia",
+	"no": "norway",
+	"se": "sweden",
+}
+
+func (s *meetupGraphQLSource) GetGroup() (*types.AutogenMeetupGroup, error) {
+	data := struct {
+		Group *meetupGroupGQL `json:"groupByUrlname"`
+	}{}
+	if err := s.client.Query(groupQuery, map[string]interface{}{"urlname": s.urlname}, false, &data); err != nil {
+		return nil, err
+	}
+	if data.Group == nil {
+		return nil, fmt.Errorf("meetup.com group %q not found", s.urlname)
+	}
+	mg := data.Group
+	result := &types.AutogenMeetupGroup{}
+	result.Members = mg.Stats.MemberCounts.All
+	result.Description = mg.Description
+	if mg.KeyGroupPhoto != nil {
+		result.Photo = mg.KeyGroupPhoto.HighResURL
+	}
+	result.Country, result.City = normalizeLocation(mg.Country, mg.City)
+	result.Name = mg.Name
+	return result, nil
+}
+
+func (s *meetupGraphQLSource) GetEvents(loc *time.Location) ([]types.AutogenMeetup, error) {
+	result := []types.AutogenMeetup{}
+	for _, status := range []string{"PAST", "ACTIVE"} {
+		list := fmt.Sprintf("the %s events of %s", strings.ToLower(status), s.urlname)
+		cursor := ""
+		for pages := 1; ; pages++ {
+			data := struct {
+				Group *struct {
+					Events struct {
+						PageInfo pageInfoGQL `json:"pageInfo"`
+						Edges    []struct {
+							Node meetupEventGQL `json:"node"`
+						} `json:"edges"`
+					} `json:"events"`
+				} `json:"groupByUrlname"`
+			}{}
+			vars := pageVariables(map[string]interface{}{"urlname": s.urlname, "status": status}, cursor)
+			if err := s.client.Query(eventsQuery, vars, false, &data); err != nil {
+				return nil, err
+			}
+			if data.Group == nil {
+				return nil, fmt.Errorf("meetup.com group %q not found", s.urlname)
+			}
+			for _, edge := range data.Group.Events.Edges {
+				meetup, err := edge.Node.toAutogenMeetup(loc)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, *meetup)
+			}
+			next, err := data.Group.Events.PageInfo.next(list, pages)
+			if err != nil {
+				return nil, err
+			}
+			if len(next) == 0 {
+				s.client.pages.add(list, pages)
+				break
+			}
+			cursor = next
+		}
+	}
+	return result, nil
+}
+
+func (ev *meetupEventGQL) toAutogenMeetup(loc *time.Location) (*types.AutogenMeetup, error) {
+	id, err := strconv.ParseUint(ev.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid meetup.com event ID %q", ev.ID)
+	}
+	t, err := parseEventTime(ev.DateTime, loc)
+	if err != nil {
+		return nil, fmt.Errorf("event %s: %v", ev.ID, err)
+	}
+	d, err := parseISODuration(ev.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("event %s: %v", ev.ID, err)
+	}
+	meetup := &types.AutogenMeetup{}
+	meetup.ID = id
+	if ev.FeaturedEventPhoto != nil {
+		meetup.Photo = ev.FeaturedEventPhoto.HighResURL
+	}
+	meetup.Date = *t
+	meetup.Name = ev.Title
+	if ev.Venue != nil {
+		meetup.Address = ev.Venue.Address
+	}
+	meetup.Duration = types.Duration{Duration: d}
+	meetup.Attendees = ev.Going
+	return meetup, nil
+}
+
+func (s *meetupGraphQLSource) GetAttendance(eventID uint64) (map[uint64]uint64, error) {
+	list := fmt.Sprintf("the attendance of event %d", eventID)
+	attendance := []meetupAttendanceAPI{}
+	cursor := ""
+	for pages := 1; ; pages++ {
+		data := struct {
+			Event *struct {
+				RSVPs struct {
+					PageInfo pageInfoGQL `json:"pageInfo"`
+					Edges    []struct {
+						Node meetupRSVPGQL `json:"node"`
+					} `json:"edges"`
+				} `json:"rsvps"`
+			} `json:"event"`
+		}{}
+		vars := pageVariables(map[string]interface{}{"id": strconv.FormatUint(eventID, 10)}, cursor)
+		// The attendance list of a past event doesn't change anymore
+		if err := s.client.Query(attendanceQuery, vars, true, &data); err != nil {
+			return nil, err
+		}
+		if data.Event == nil {
+			return nil, fmt.Errorf("meetup.com event %d not found", eventID)
+		}
+		for _, edge := range data.Event.RSVPs.Edges {
+			memberID, err := strconv.ParseUint(edge.Node.Member.ID, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid meetup.com member ID %q", edge.Node.Member.ID)
+			}
+			a := meetupAttendanceAPI{}
+			a.Member.ID = memberID
+			a.RSVP.Response = strings.ToLower(edge.Node.Status)
+			a.RSVP.Guests = edge.Node.GuestsCount
+			attendance = append(attendance, a)
+		}
+		next, err := data.Event.RSVPs.PageInfo.next(list, pages)
+		if err != nil {
+			return nil, err
+		}
+		if len(next) == 0 {
+			s.client.pages.add(list, pages)
+			break
+		}
+		cursor = next
+	}
+	return attendanceToRSVPList(attendance), nil
+}
+
+// parseEventTime parses the dateTime of an event, e.g. 2019-03-12T18:00+01:00, and returns it in loc
+func parseEventTime(s string, loc *time.Location) (*types.Time, error) {
+	for _, layout := range []string{"2006-01-02T15:04:05Z07:00", "2006-01-02T15:04Z07:00"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &types.Time{Time: t.In(loc)}, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid date %q", s)
+}
+
+var isoDurationRegexp = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseISODuration parses an ISO 8601 duration like PT2H30M, as used by the GraphQL API
+func parseISODuration(s string) (time.Duration, error) {
+	if len(s) == 0 {
+		return 0, nil
+	}
+	match := isoDurationRegexp.FindStringSubmatch(s)
+	if match == nil || s == "P" || s == "PT" {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	d := time.Duration(0)
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if len(match[i+1]) == 0 {
+			continue
+		}
+		n, err := strconv.ParseInt(match[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		d += time.Duration(n) * unit
+	}
+	return d, nil
+}
@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// maxPages is the most pages followed for a single list, in case a server keeps linking to more
+const maxPages = 1000
+
+// pageCounter counts the pages fetched per list, e.g. the events of a meetup group, to report
+// at the end of a run how far the pagination was followed
+type pageCounter struct {
+	mux   sync.Mutex
+	lists map[string]int
+}
+
+func newPageCounter() *pageCounter {
+	return &pageCounter{lists: map[string]int{}}
+}
+
+// add records that all pages of the given list were fetched
+func (p *pageCounter) add(list string, pages int) {
+	if p == nil {
+		return
+	}
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.lists[list] += pages
+}
+
+// Report logs how many pages were fetched during this run, and which lists had more than one
+func (p *pageCounter) Report() {
+	if p == nil {
+		return
+	}
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	pages := 0
+	paginated := []string{}
+	for list, n := range p.lists {
+		pages += n
+		if n > 1 {
+			paginated = append(paginated, list)
+		}
+	}
+	if len(p.lists) == 0 {
+		return
+	}
+	sort.Strings(paginated)
+	for _, list := range paginated {
+		log.Debugf("Followed %d pages for %s", p.lists[list], list)
+	}
+	log.Infof("Pagination: fetched %d pages for %d lists, %d lists had more than one page", pages, len(p.lists), len(paginated))
+}
+
+// getAllPages gets all items of a list from the REST API into v, starting at rawURL. The pages
+// are followed through the Link header, or else through the offset parameter as long as the
+// pages are full, for the endpoints without Link headers.
+func (s *meetupDotComSource) getAllPages(list, rawURL string, pageSize int, immutable bool, v interface{}) error {
+	items := []json.RawMessage{}
+	pages := 0
+	for offset := 1; len(rawURL) != 0; offset++ {
+		if pages == maxPages {
+			return fmt.Errorf("%s has more than %d pages", list, maxPages)
+		}
+		page := []json.RawMessage{}
+		next, err := s.cache.GetJSONPage(rawURL, immutable, &page)
+		if err != nil {
+			return err
+		}
+		pages++
+		items = append(items, page...)
+		if len(next) == 0 && len(page) >= pageSize {
+			if next, err = withOffset(rawURL, offset); err != nil {
+				return err
+			}
+		}
+		rawURL = next
+	}
+	s.pages.add(list, pages)
+
+	b, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// withOffset sets the offset parameter of the REST API, which counts pages
+func withOffset(rawURL string, offset int) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("offset", strconv.Itoa(offset))
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"html"
+	"html/template"
+	"io"
+	"net/url"
+	"strings"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// sanitizedTags are the tags kept by sanitizeHTML, all other tags are dropped, keeping their text
+var sanitizedTags = map[string]bool{
+	"a": true, "b": true, "br": true, "em": true, "i": true, "li": true,
+	"ol": true, "p": true, "strong": true, "u": true, "ul": true,
+}
+
+// droppedTags are dropped by sanitizeHTML together with their content
+var droppedTags = map[string]bool{
+	"script": true, "style": true, "noscript": true, "template": true, "iframe": true, "object": true,
+}
+
+// sanitizedSchemes are the schemes of the links kept by sanitizeHTML
+var sanitizedSchemes = map[string]bool{"http": true, "https": true, "mailto": true}
+
+// sanitizeHTML returns the HTML fragment s, like the description of a meetup group from meetup.com,
+// with only the tags of sanitizedTags and without any attributes but the href of links to web pages
+// or mail addresses. Everything else is escaped, so s can't inject scripts or styles into the site.
+func sanitizeHTML(s string) template.HTML {
+	out := &strings.Builder{}
+	open := []string{}
+	dropping := 0
+	z := nethtml.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		if tt == nethtml.ErrorToken {
+			if z.Err() != io.EOF {
+				out.WriteString(html.EscapeString(string(z.Raw())))
+			}
+			break
+		}
+		token := z.Token()
+		switch tt {
+		case nethtml.TextToken:
+			if dropping == 0 {
+				out.WriteString(html.EscapeString(token.Data))
+			}
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			if droppedTags[token.Data] {
+				if tt == nethtml.StartTagToken {
+					dropping++
+				}
+				continue
+			}
+			if dropping != 0 || !sanitizedTags[token.Data] {
+				continue
+			}
+			out.WriteString("<" + token.Data)
+			if token.Data == "a" {
+				if href := sanitizedHref(token.Attr); len(href) != 0 {
+					out.WriteString(` href="` + html.EscapeString(href) + `" rel="nofollow noopener"`)
+				}
+			}
+			out.WriteString(">")
+			if token.Data != "br" {
+				open = append(open, token.Data)
+			}
+		case nethtml.EndTagToken:
+			if droppedTags[token.Data] {
+				if dropping != 0 {
+					dropping--
+				}
+				continue
+			}
+			// Close the tags opened since the matching start tag, ignore end tags without one
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != token.Data {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					out.WriteString("</" + open[j] + ">")
+				}
+				open = open[:i]
+				break
+			}
+		}
+	}
+	for i := len(open) - 1; i >= 0; i-- {
+		out.WriteString("</" + open[i] + ">")
+	}
+	return template.HTML(out.String())
+}
+
+// sanitizedHref returns the href of a link, if it points to a web page or a mail address
+func sanitizedHref(attrs []nethtml.Attribute) string {
+	for _, attr := range attrs {
+		if attr.Key != "href" {
+			continue
+		}
+		u, err := url.Parse(strings.TrimSpace(attr.Val))
+		if err != nil || !sanitizedSchemes[strings.ToLower(u.Scheme)] {
+			return ""
+		}
+		return u.String()
+	}
+	return ""
+}
@@ -0,0 +1,167 @@
+package generator
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+// retentionWindow is how soon attendees have to RSVP again to count as retained
+const retentionWindow = 12 // months
+
+// aggregateSeries computes the statistics of all meetup groups and of each of them per month or year.
+// The attendees are identified by their meetup.com user ID, so attendees of several meetup groups
+// are only counted once in the statistics of all meetup groups.
+func aggregateSeries(cfg *types.Config, period types.StatsPeriod) types.StatsSeries {
+	now := time.Now().UTC()
+	all := pastMeetups(cfg.MeetupGroups, now)
+	starts := periods(all, period, now)
+
+	series := types.StatsSeries{
+		AllMeetups: seriesPoints(all, starts, period, now),
+		PerMeetup:  map[string][]types.SeriesPoint{},
+	}
+	for _, mg := range cfg.MeetupGroups {
+		meetups := pastMeetups([]types.MeetupGroup{mg}, now)
+		series.PerMeetup[mg.CityLowercase()] = seriesPoints(meetups, starts, period, now)
+	}
+	return series
+}
+
+// pastMeetups returns the meetups of the groups that have taken place, sorted by date
+func pastMeetups(mgs []types.MeetupGroup, now time.Time) []types.Meetup {
+	meetups := []types.Meetup{}
+	for _, mg := range mgs {
+		for _, m := range mg.Meetups {
+			if m.AutogenMeetup == nil || !m.Date.UTC().Before(now) {
+				continue
+			}
+			meetups = append(meetups, m)
+		}
+	}
+	sort.SliceStable(meetups, func(i, j int) bool {
+		return meetups[i].Date.Before(meetups[j].Date.Time)
+	})
+	return meetups
+}
+
+// seriesPoints computes the statistics of the meetups, sorted by date, in the periods starting at starts
+func seriesPoints(meetups []types.Meetup, starts []time.Time, period types.StatsPeriod, now time.Time) []types.SeriesPoint {
+	// Find out when every attendee, speaker and sponsor showed up for the first time
+	firstAttended := map[uint64]time.Time{}
+	firstSpoke := map[types.SpeakerID]time.Time{}
+	firstSponsored := map[types.CompanyID]time.Time{}
+	// attended maps every attendee to the dates of the meetups they RSVPed for, sorted
+	attended := map[uint64][]time.Time{}
+	for _, m := range meetups {
+		date := m.Date.UTC()
+		for userID := range m.RSVPs {
+			if _, ok := firstAttended[userID]; !ok {
+				firstAttended[userID] = date
+			}
+			attended[userID] = append(attended[userID], date)
+		}
+		for _, p := range m.Presentations {
+			for _, s := range p.Speakers {
+				if _, ok := firstSpoke[s.RefID()]; !ok {
+					firstSpoke[s.RefID()] = date
+				}
+			}
+		}
+		for _, s := range m.Sponsors {
+			if _, ok := firstSponsored[s.Company.RefID()]; !ok {
+				firstSponsored[s.Company.RefID()] = date
+			}
+		}
+	}
+
+	points := make([]types.SeriesPoint, 0, len(starts))
+	for _, start := range starts {
+		end := period.End(start)
+		point := types.SeriesPoint{Period: period.Name(start)}
+		in := func(t time.Time) bool {
+			return !t.Before(start) && t.Before(end)
+		}
+
+		// lastAttended maps the attendees of the period to their last meetup in it
+		lastAttended := map[uint64]time.Time{}
+		for _, m := range meetups {
+			date := m.Date.UTC()
+			if !in(date) {
+				continue
+			}
+			point.Meetups++
+			point.RSVPs += m.Attendees
+			for userID := range m.RSVPs {
+				lastAttended[userID] = date
+			}
+		}
+		for _, t := range firstAttended {
+			if in(t) {
+				point.NewAttendees++
+			}
+		}
+		for _, t := range firstSpoke {
+			if in(t) {
+				point.NewSpeakers++
+			}
+		}
+		for _, t := range firstSponsored {
+			if in(t) {
+				point.NewSponsors++
+			}
+		}
+
+		if len(lastAttended) != 0 && !end.AddDate(0, retentionWindow, 0).After(now) {
+			retained := 0
+			for userID, last := range lastAttended {
+				for _, t := range attended[userID] {
+					if t.After(last) && !t.After(last.AddDate(0, retentionWindow, 0)) {
+						retained++
+						break
+					}
+				}
+			}
+			retention := round(float64(retained) / float64(len(lastAttended)))
+			point.Retention = &retention
+		}
+		points = append(points, point)
+	}
+
+	// Compare with the same period a year earlier
+	yearAgo := 1
+	if period == types.StatsPeriodMonth {
+		yearAgo = 12
+	}
+	for i := yearAgo; i < len(points); i++ {
+		prev, cur := points[i-yearAgo], points[i]
+		if prev.Meetups == 0 {
+			// Nothing happened to compare with
+			continue
+		}
+		points[i].YearOverYear = &types.SeriesTrend{
+			Meetups:      change(prev.Meetups, cur.Meetups),
+			RSVPs:        change(prev.RSVPs, cur.RSVPs),
+			NewAttendees: change(prev.NewAttendees, cur.NewAttendees),
+			NewSpeakers:  change(prev.NewSpeakers, cur.NewSpeakers),
+			NewSponsors:  change(prev.NewSponsors, cur.NewSponsors),
+		}
+	}
+	return points
+}
+
+// change returns the relative change from prev to cur, or nil if prev is zero
+func change(prev, cur uint64) *float64 {
+	if prev == 0 {
+		return nil
+	}
+	c := round((float64(cur) - float64(prev)) / float64(prev))
+	return &c
+}
+
+// round rounds to three decimals, to keep stats.json readable
+func round(f float64) float64 {
+	return math.Round(f*1000) / 1000
+}
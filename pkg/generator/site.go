@@ -0,0 +1,200 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+const siteLayout = "layout.html"
+
+// sitePage is the data the page templates of the site are executed with
+type sitePage struct {
+	// Root is the relative path from the page to the root of the site, e.g. "../".
+	// All links are relative, for the site to work when served from a subdirectory.
+	Root    string
+	Config  *types.Config
+	Graph   *Graph
+	Group   *types.MeetupGroup
+	Meetup  *MeetupRef
+	Speaker *types.Speaker
+	Company *types.Company
+	// Markers are shown on the map on the index page
+	Markers []siteMarker
+}
+
+type siteMarker struct {
+	Name string  `json:"name"`
+	URL  string  `json:"url"`
+	Lat  float64 `json:"lat"`
+	Lng  float64 `json:"lng"`
+}
+
+// siteTheme holds the templates and static files of the site
+type siteTheme struct {
+	// base holds the layout and the templates shared between the pages
+	base *template.Template
+	// pages maps the name of the page templates to their content
+	pages map[string]string
+	// assets are copied to the root of the site as-is
+	assets map[string][]byte
+}
+
+var siteFuncs = template.FuncMap{
+	"date":         formatDate,
+	"lower":        strings.ToLower,
+	"sanitizeHTML": sanitizeHTML,
+	"groupPath":    groupPath,
+	"meetupPath":   meetupPath,
+	"speakerPath":  speakerPath,
+	"companyPath":  companyPath,
+}
+
+func groupPath(mg types.MeetupGroup) string {
+	return mg.CityLowercase() + "/index.html"
+}
+
+func meetupPath(m MeetupRef) string {
+	return fmt.Sprintf("%s/%s.html", m.Group.CityLowercase(), m.Key)
+}
+
+func speakerPath(id types.SpeakerID) string {
+	return fmt.Sprintf("speakers/%s.html", id)
+}
+
+func companyPath(id types.CompanyID) string {
+	return fmt.Sprintf("companies/%s.html", id)
+}
+
+// loadSiteTheme parses the default theme, and then the files in dir, if it is set.
+// HTML files named like one of the defaults override it, other HTML files can
+// hold {{ define }} blocks shared between the pages. All other files are assets.
+func loadSiteTheme(dir string) (*siteTheme, error) {
+	theme := &siteTheme{
+		base:   template.New("").Funcs(siteFuncs),
+		pages:  map[string]string{},
+		assets: map[string][]byte{},
+	}
+	files := map[string][]byte{}
+	for name, content := range DefaultSiteTheme {
+		files[name] = []byte(content)
+	}
+	if len(dir) != 0 {
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if info.IsDir() {
+				continue
+			}
+			b, err := ioutil.ReadFile(filepath.Join(dir, info.Name()))
+			if err != nil {
+				return nil, err
+			}
+			files[info.Name()] = b
+		}
+	}
+
+	for name, b := range files {
+		switch {
+		case isSitePage(name):
+			theme.pages[name] = string(b)
+		case filepath.Ext(name) == ".html":
+			if _, err := theme.base.New(name).Parse(string(b)); err != nil {
+				return nil, fmt.Errorf("couldn't parse template %s: %v", name, err)
+			}
+		default:
+			theme.assets[name] = b
+		}
+	}
+	return theme, nil
+}
+
+func isSitePage(name string) bool {
+	for _, page := range sitePages {
+		if page == name {
+			return true
+		}
+	}
+	return false
+}
+
+// render executes the layout with the given page template
+func (t *siteTheme) render(page string, data *sitePage) ([]byte, error) {
+	tmpl, err := t.base.Clone()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tmpl.New(page).Parse(t.pages[page]); err != nil {
+		return nil, fmt.Errorf("couldn't parse template %s: %v", page, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, siteLayout, data); err != nil {
+		return nil, fmt.Errorf("couldn't render %s: %v", page, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// site renders the HTML site, with a page for every meetup group, meetup, speaker
+// and company. The returned paths are relative to the root of the site.
+func site(cfg *types.Config, theme *siteTheme, calendars *calendarHistory) (map[string][]byte, error) {
+	result := map[string][]byte{}
+	for name, b := range theme.assets {
+		result[name] = b
+	}
+	graph := NewGraph(cfg)
+	render := func(path, page string, data sitePage) error {
+		data.Root = strings.Repeat("../", strings.Count(path, "/"))
+		data.Config = cfg
+		data.Graph = graph
+		b, err := theme.render(page, &data)
+		if err != nil {
+			return err
+		}
+		result[path] = b
+		return nil
+	}
+
+	markers := []siteMarker{}
+	for _, mg := range cfg.MeetupGroups {
+		if mg.Latitude == 0 && mg.Longitude == 0 {
+			continue
+		}
+		markers = append(markers, siteMarker{Name: mg.Name, URL: groupPath(mg), Lat: mg.Latitude, Lng: mg.Longitude})
+	}
+	if err := render("index.html", "index.html", sitePage{Markers: markers}); err != nil {
+		return nil, err
+	}
+	for i := range cfg.MeetupGroups {
+		mg := &cfg.MeetupGroups[i]
+		if err := render(groupPath(*mg), "group.html", sitePage{Group: mg}); err != nil {
+			return nil, err
+		}
+		result[filepath.Join(mg.CityLowercase(), meetupsICSFile)] = meetupGroupICS(mg, calendars)
+	}
+	for i := range graph.Meetups {
+		m := &graph.Meetups[i]
+		if err := render(meetupPath(*m), "meetup.html", sitePage{Group: m.Group, Meetup: m}); err != nil {
+			return nil, err
+		}
+	}
+	for i := range cfg.Speakers {
+		s := &cfg.Speakers[i]
+		if err := render(speakerPath(s.ID), "speaker.html", sitePage{Speaker: s}); err != nil {
+			return nil, err
+		}
+	}
+	for i := range cfg.Companies {
+		c := &cfg.Companies[i]
+		if err := render(companyPath(c.ID), "company.html", sitePage{Company: c}); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
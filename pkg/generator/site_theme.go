@@ -0,0 +1,200 @@
+package generator
+
+// sitePages are the templates rendered into pages, each executed through the layout
+var sitePages = []string{"index.html", "group.html", "meetup.html", "speaker.html", "company.html"}
+
+// DefaultSiteTheme maps the names of the files of the default site theme to their content
+var DefaultSiteTheme = map[string]string{
+	"layout.html":  siteLayoutTmplStr,
+	"index.html":   siteIndexTmplStr,
+	"group.html":   siteGroupTmplStr,
+	"meetup.html":  siteMeetupTmplStr,
+	"speaker.html": siteSpeakerTmplStr,
+	"company.html": siteCompanyTmplStr,
+	"style.css":    siteStyleStr,
+}
+
+const (
+	siteLayoutTmplStr = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{ template "title" . }}</title>
+  <link rel="stylesheet" href="{{ .Root }}style.css">
+  {{ block "head" . }}{{ end }}
+</head>
+<body>
+  <header><a href="{{ .Root }}index.html">Meetups</a></header>
+  <main>
+{{ template "content" . }}
+  </main>
+</body>
+</html>
+`
+
+	siteIndexTmplStr = `{{ define "title" }}Meetups{{ end }}
+{{ define "head" }}<link rel="stylesheet" href="https://unpkg.com/leaflet@1.6.0/dist/leaflet.css">
+  <script src="https://unpkg.com/leaflet@1.6.0/dist/leaflet.js"></script>{{ end }}
+{{ define "content" }}
+<h1>Meetups</h1>
+<div id="map"></div>
+<script>
+  var map = L.map("map");
+  L.tileLayer("https://{s}.tile.openstreetmap.org/{z}/{x}/{y}.png", {
+    attribution: "&copy; <a href=\"https://www.openstreetmap.org/copyright\">OpenStreetMap</a> contributors"
+  }).addTo(map);
+  var markers = {{ .Markers }};
+  var bounds = [];
+  markers.forEach(function(m) {
+    var link = document.createElement("a");
+    link.href = m.url;
+    link.textContent = m.name;
+    L.marker([m.lat, m.lng]).addTo(map).bindPopup(link);
+    bounds.push([m.lat, m.lng]);
+  });
+  if (bounds.length) {
+    map.fitBounds(bounds, {padding: [40, 40], maxZoom: 8});
+  } else {
+    map.setView([0, 0], 1);
+  }
+</script>
+<h2>Meetup groups</h2>
+<ul>
+{{ range .Config.MeetupGroups }}  <li><a href="{{ $.Root }}{{ groupPath . }}">{{ .Name }}</a> ({{ .City }}, {{ .Country }})</li>
+{{ end }}</ul>
+<h2>Upcoming and past meetups</h2>
+<ul>
+{{ range .Graph.Meetups }}{{ if .AutogenMeetup }}  <li>{{ date "2 Jan 2006" .Date }}: <a href="{{ $.Root }}{{ meetupPath . }}">{{ .Name }}</a> in {{ .Group.City }}</li>
+{{ end }}{{ end }}</ul>
+{{ end }}
+`
+
+	siteGroupTmplStr = `{{ define "title" }}{{ .Group.Name }}{{ end }}
+{{ define "content" }}{{ with .Group }}
+<h1>{{ .Name }}</h1>
+{{ if .Photo }}<img class="logo" alt="Meetup Group Logo" src="{{ .Photo }}">{{ end }}
+<div class="description">{{ sanitizeHTML .Description }}</div>
+<p>
+  <a href="https://www.meetup.com/{{ .MeetupID }}">Join on meetup.com</a> &middot;
+  <a href="meetups.ics">Subscribe to the calendar</a>{{ if .CFP }} &middot;
+  <a href="{{ .CFP }}">Submit a talk</a>{{ end }}
+</p>
+<h2>Organizers</h2>
+<ul>
+{{ range .Organizers }}{{ if .Speaker }}  <li><a href="{{ $.Root }}{{ speakerPath .ID }}">{{ .Name }}</a></li>
+{{ end }}{{ end }}</ul>
+{{ if .EcosystemMembers }}<h2>Ecosystem members</h2>
+<ul>
+{{ range .EcosystemMembers }}{{ if .Company }}  <li><a href="{{ $.Root }}{{ companyPath .ID }}">{{ .Name }}</a></li>
+{{ end }}{{ end }}</ul>
+{{ end }}{{ end }}
+<h2>Meetups</h2>
+<ul>
+{{ range .Graph.MeetupsOf .Group }}  <li><a href="{{ $.Root }}{{ meetupPath . }}">{{ if .AutogenMeetup }}{{ date "2 Jan 2006" .Date }}: {{ .Name }}{{ else }}{{ .Key }}{{ end }}</a></li>
+{{ end }}</ul>
+{{ end }}
+`
+
+	siteMeetupTmplStr = `{{ define "title" }}{{ if .Meetup.AutogenMeetup }}{{ .Meetup.Name }}{{ else }}{{ .Meetup.Key }}{{ end }} - {{ .Group.Name }}{{ end }}
+{{ define "content" }}{{ with .Meetup }}
+<h1>{{ if .AutogenMeetup }}{{ .Name }}{{ else }}{{ .Key }}{{ end }}</h1>
+<p>Organized by <a href="{{ $.Root }}{{ groupPath .Group }}">{{ .Group.Name }}</a></p>
+<ul>
+{{ if .AutogenMeetup }}  <li>Date: {{ .DateTime }}</li>
+  <li>Address: {{ .Address }}</li>
+  <li><a href="https://www.meetup.com/{{ .Group.MeetupID }}/events/{{ .ID }}">Meetup link</a></li>
+{{ end }}{{ if .Recording }}  <li><a href="{{ .Recording }}">Recording</a></li>
+{{ end }}</ul>
+{{ if .Sponsors }}<h2>Sponsors</h2>
+<ul>
+{{ range .Sponsors }}{{ if .Company.Company }}  <li>{{ .Role }}: <a href="{{ $.Root }}{{ companyPath .Company.ID }}">{{ .Company.Name }}</a></li>
+{{ end }}{{ end }}</ul>
+{{ end }}<h2>Agenda</h2>
+<ul>
+{{ range .Presentations }}  <li>
+    {{ .StartTime }} - {{ .EndTime }}: {{ .Title }}
+    {{ range .Speakers }}{{ if .Speaker }}<br><a href="{{ $.Root }}{{ speakerPath .ID }}">{{ .Name }}</a>{{ end }}{{ end }}
+    {{ if .Slides }}<br><a href="{{ .Slides }}">Slides</a>{{ end }}{{ if .Recording }}<br><a href="{{ .Recording }}">Recording</a>{{ end }}
+  </li>
+{{ end }}</ul>
+{{ end }}{{ end }}
+`
+
+	siteSpeakerTmplStr = `{{ define "title" }}{{ .Speaker.Name }}{{ end }}
+{{ define "content" }}{{ with .Speaker }}
+<h1>{{ .Name }}</h1>
+<ul>
+{{ if .Title }}  <li>{{ .Title }}</li>
+{{ end }}{{ if .Company.Company }}  <li>Works at <a href="{{ $.Root }}{{ companyPath .Company.ID }}">{{ .Company.Name }}</a></li>
+{{ end }}{{ if .Github }}  <li><a href="https://github.com/{{ .Github }}">GitHub</a></li>
+{{ end }}{{ if .Twitter }}  <li><a href="https://twitter.com/{{ .Twitter }}">Twitter</a></li>
+{{ end }}{{ if .SpeakersBureau }}  <li><a href="https://www.cncf.io/speaker/{{ .SpeakersBureau }}">CNCF Speakers Bureau</a></li>
+{{ end }}</ul>
+{{ with $.Graph.Organizing .ID }}<h2>Organizing</h2>
+<ul>
+{{ range . }}  <li><a href="{{ $.Root }}{{ groupPath . }}">{{ .Name }}</a></li>
+{{ end }}</ul>
+{{ end }}{{ with $.Graph.Talks .ID }}<h2>Talks</h2>
+<ul>
+{{ range . }}  <li>{{ .Presentation.Title }} at <a href="{{ $.Root }}{{ meetupPath .Meetup }}">{{ .Meetup.Group.Name }}, {{ .Meetup.Key }}</a></li>
+{{ end }}</ul>
+{{ end }}{{ end }}{{ end }}
+`
+
+	siteCompanyTmplStr = `{{ define "title" }}{{ .Company.Name }}{{ end }}
+{{ define "content" }}{{ with .Company }}
+<h1>{{ .Name }}</h1>
+{{ if .LogoURL }}<img class="logo{{ if .WhiteLogo }} white{{ end }}" alt="{{ .Name }}" src="{{ .LogoURL }}">{{ end }}
+{{ if .WebsiteURL }}<p><a href="{{ .WebsiteURL }}">{{ .WebsiteURL }}</a></p>{{ end }}
+{{ with $.Graph.EcosystemMemberOf .ID }}<h2>Ecosystem member of</h2>
+<ul>
+{{ range . }}  <li><a href="{{ $.Root }}{{ groupPath . }}">{{ .Name }}</a></li>
+{{ end }}</ul>
+{{ end }}{{ with $.Graph.Sponsorships .ID }}<h2>Sponsored meetups</h2>
+<ul>
+{{ range . }}  <li>{{ .Role }}: <a href="{{ $.Root }}{{ meetupPath .Meetup }}">{{ .Meetup.Group.Name }}, {{ .Meetup.Key }}</a></li>
+{{ end }}</ul>
+{{ end }}{{ with $.Graph.Employees .ID }}<h2>Speakers</h2>
+<ul>
+{{ range . }}  <li><a href="{{ $.Root }}{{ speakerPath .ID }}">{{ .Name }}</a></li>
+{{ end }}</ul>
+{{ end }}{{ end }}{{ end }}
+`
+
+	siteStyleStr = `body {
+  font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif;
+  line-height: 1.5;
+  margin: 0;
+  color: #24292e;
+}
+header {
+  background: #0f4c81;
+  padding: 1em 2em;
+}
+header a {
+  color: #fff;
+  font-weight: bold;
+  text-decoration: none;
+}
+main {
+  max-width: 60em;
+  margin: 0 auto;
+  padding: 1em 2em;
+}
+a {
+  color: #0f4c81;
+}
+#map {
+  height: 400px;
+}
+img.logo {
+  float: right;
+  max-width: 30%;
+  margin: 0 0 1em 1em;
+}
+img.logo.white {
+  background: #24292e;
+}
+`
+)
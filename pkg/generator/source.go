@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+// EventSource provides the information about a meetup group that isn't written
+// by hand in meetup.yaml, e.g. its description, events and attendance lists.
+type EventSource interface {
+	// GetGroup returns general information about the meetup group. AutoMeetups is
+	// not set in the result, the events are fetched separately using GetEvents.
+	GetGroup() (*types.AutogenMeetupGroup, error)
+	// GetEvents returns all past and upcoming events of the meetup group. Attendees
+	// is set to the amount of RSVPs registered for the event. Local times without
+	// a UTC offset are in loc, the time zone of the meetup group.
+	GetEvents(loc *time.Location) ([]types.AutogenMeetup, error)
+	// GetAttendance returns the attendance list of a past event as a map of user ID
+	// and amount of RSVPs for that user (the user itself + guests)
+	GetAttendance(eventID uint64) (map[uint64]uint64, error)
+}
+
+// NewEventSource returns the EventSource configured for the given meetup group.
+// groupDir is the directory of the group's meetup.yaml file, relative paths in
+// the source configuration are resolved against it. meetup.com is accessed through
+// client, which also caches the responses of the REST API.
+func NewEventSource(mg *types.MeetupGroup, groupDir string, client *MeetupClient) (EventSource, error) {
+	if mg.Source == nil {
+		return newMeetupGraphQLSource(mg.MeetupID, client), nil
+	}
+	switch mg.Source.Type {
+	case "", types.EventSourceMeetupDotCom:
+		return newMeetupGraphQLSource(mg.MeetupID, client), nil
+	case types.EventSourceMeetupDotComREST:
+		return newMeetupDotComSource(mg.MeetupID, client.cache, client.pages), nil
+	case types.EventSourceFile:
+		if len(mg.Source.Path) == 0 {
+			return nil, fmt.Errorf("meetup group %q: the %s event source requires a path", mg.MeetupID, mg.Source.Type)
+		}
+		path := mg.Source.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(groupDir, path)
+		}
+		return newFileSource(path), nil
+	default:
+		return nil, fmt.Errorf("meetup group %q: unknown event source type %q", mg.MeetupID, mg.Source.Type)
+	}
+}
+
+// normalizeLocation returns the country and city of a meetup group the way all event sources report
+// them: the country as its lowercase name, also if it is given as an ISO code, see countryNames, and
+// the city spelled as in cityNameExceptions
+func normalizeLocation(country, city string) (string, string) {
+	country = strings.ToLower(strings.TrimSpace(country))
+	if name, ok := countryNames[country]; ok {
+		country = name
+	}
+	city = strings.TrimSpace(city)
+	if name, ok := cityNameExceptions[city]; ok {
+		city = name
+	}
+	return country, city
+}
+
+// GetMeetupInfo fetches all information it can about the given meetup group
+// from the given source, and returns the autogenerated type
+func GetMeetupInfo(src EventSource, humanGen types.MeetupGroup) (*types.AutogenMeetupGroup, error) {
+	result, err := src.GetGroup()
+	if err != nil {
+		return nil, err
+	}
+	loc, err := groupLocation(humanGen, result.Country)
+	if err != nil {
+		return nil, err
+	}
+	events, err := src.GetEvents(loc)
+	if err != nil {
+		return nil, err
+	}
+	result.AutoMeetups = []types.AutogenMeetup{}
+
+	for _, meetup := range events {
+		// The meetups are matched by their local date, and rendered in the local time
+		meetup.Date.Time = meetup.Date.In(loc)
+		if humanGen.IsIgnored(&meetup) {
+			continue
+		}
+
+		if time.Now().UTC().After(meetup.Date.Time) {
+			// Only collect RSVP data after the event
+			meetup.RSVPs, err = src.GetAttendance(meetup.ID)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			meetup.Attendees = 0
+		}
+
+		result.AutoMeetups = append(result.AutoMeetups, meetup)
+	}
+	return result, nil
+}
@@ -0,0 +1,116 @@
+package generator
+
+import (
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+// firstPresentation is the first meetup a speaker presented at, in any meetup group
+type firstPresentation struct {
+	date time.Time
+}
+
+// firstPresentations returns the first presentation of every speaker at the past meetups of the groups
+func firstPresentations(mgs []types.MeetupGroup, now time.Time) map[types.SpeakerID]firstPresentation {
+	result := map[types.SpeakerID]firstPresentation{}
+	for _, mg := range mgs {
+		for _, m := range mg.Meetups {
+			if m.AutogenMeetup == nil || !m.Date.Before(now) {
+				continue
+			}
+			for _, p := range m.Presentations {
+				for _, s := range p.Speakers {
+					first, ok := result[s.RefID()]
+					if !ok || m.Date.Before(first.date) {
+						result[s.RefID()] = firstPresentation{date: m.Date.Time}
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// speakerStats computes the statistics about the speakers presenting at the meetups of the groups in [from, to).
+// Whether a speaker presented before is decided by the first presentations at any of the meetup groups.
+func speakerStats(mgs []types.MeetupGroup, from, to time.Time, firsts map[types.SpeakerID]firstPresentation) *types.SpeakerStats {
+	speakers := map[types.SpeakerID]bool{}
+	firstTime := map[types.SpeakerID]bool{}
+	repeat := map[types.SpeakerID]bool{}
+	// tiers holds the sponsor tiers of the companies of the speakers in the groups they presented in
+	tiers := map[types.SpeakerID]map[types.SponsorTier]bool{}
+	for _, mg := range mgs {
+		for _, m := range mg.Meetups {
+			if m.AutogenMeetup == nil {
+				continue
+			}
+			date := m.Date.UTC()
+			if date.Before(from) || !date.Before(to) {
+				continue
+			}
+			for _, p := range m.Presentations {
+				for _, s := range p.Speakers {
+					id := s.RefID()
+					speakers[id] = true
+					if first := firsts[id]; m.Date.After(first.date) {
+						repeat[id] = true
+					} else if s.Speaker != nil && (s.FirstTimeSpeaker || s.FirstTalk.YYYYMMDD() == m.Date.YYYYMMDD()) {
+						firstTime[id] = true
+					}
+					if tiers[id] == nil {
+						tiers[id] = map[types.SponsorTier]bool{}
+					}
+					if s.Speaker != nil && s.Company.Company != nil && mg.AutogenMeetupGroup != nil {
+						if tier, ok := mg.SponsorTiers[s.Company.ID]; ok {
+							tiers[id][tier] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	stats := &types.SpeakerStats{
+		Speakers:          uint64(len(speakers)),
+		FirstTimeSpeakers: uint64(len(firstTime)),
+		RepeatSpeakers:    uint64(len(repeat)),
+	}
+	if stats.Speakers != 0 {
+		stats.FirstTimeSpeakerShare = round(float64(stats.FirstTimeSpeakers) / float64(stats.Speakers))
+		stats.RepeatSpeakerRatio = round(float64(stats.RepeatSpeakers) / float64(stats.Speakers))
+	}
+	for _, t := range tiers {
+		switch {
+		case t[types.SponsorTierSpeakerProvider]:
+			stats.SpeakerProviderSpeakers++
+		case len(t) != 0:
+			stats.SponsorSpeakers++
+		default:
+			stats.IndependentSpeakers++
+		}
+	}
+	return stats
+}
+
+// speakerStatsByPeriod computes the speaker statistics per period, from the period of the first
+// meetup to the current one, in total and per meetup group
+func speakerStatsByPeriod(cfg *types.Config, period types.StatsPeriod, now time.Time, firsts map[types.SpeakerID]firstPresentation) []types.PeriodSpeakerStats {
+	result := []types.PeriodSpeakerStats{}
+	for _, start := range periods(pastMeetups(cfg.MeetupGroups, now), period, now) {
+		to := period.End(start)
+		if to.After(now) {
+			to = now
+		}
+		ps := types.PeriodSpeakerStats{
+			Period:     period.Name(start),
+			AllMeetups: *speakerStats(cfg.MeetupGroups, start, to, firsts),
+			PerMeetup:  map[string]types.SpeakerStats{},
+		}
+		for _, mg := range cfg.MeetupGroups {
+			ps.PerMeetup[mg.CityLowercase()] = *speakerStats([]types.MeetupGroup{mg}, start, to, firsts)
+		}
+		result = append(result, ps)
+	}
+	return result
+}
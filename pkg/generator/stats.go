@@ -0,0 +1,167 @@
+package generator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+// AggregateStats computes the statistics of all past meetups, per meetup group and in total
+func AggregateStats(cfg *types.Config) (*types.StatsFile, error) {
+	s := &types.StatsFile{
+		MeetupGroups: uint64(len(cfg.MeetupGroups)),
+		PerMeetup:    map[string]types.MeetupStats{},
+	}
+	now := time.Now()
+	firsts := firstPresentations(cfg.MeetupGroups, now)
+	for _, mg := range cfg.MeetupGroups {
+		mgStat := meetupGroupStats(mg, time.Time{}, now)
+		mgStat.SpeakerStats = speakerStats([]types.MeetupGroup{mg}, time.Time{}, now, firsts)
+		s.PerMeetup[mg.CityLowercase()] = mgStat
+		addStats(&s.AllMeetups, mgStat)
+	}
+	s.AllMeetups.AverageRSVPs = averageRSVPs(s.AllMeetups)
+	// The speakers can't be added up, as they may present in several meetup groups
+	s.AllMeetups.SpeakerStats = speakerStats(cfg.MeetupGroups, time.Time{}, now, firsts)
+
+	s.Series = map[types.StatsPeriod]types.StatsSeries{}
+	for _, period := range []types.StatsPeriod{types.StatsPeriodMonth, types.StatsPeriodYear} {
+		s.Series[period] = aggregateSeries(cfg, period)
+	}
+	s.SpeakersByPeriod = map[types.StatsPeriod][]types.PeriodSpeakerStats{}
+	for _, period := range []types.StatsPeriod{types.StatsPeriodYear, types.StatsPeriodQuarter} {
+		s.SpeakersByPeriod[period] = speakerStatsByPeriod(cfg, period, now.UTC(), firsts)
+	}
+	return s, nil
+}
+
+// AggregateStatsByPeriod computes the statistics of the past meetups per year or quarter, from the
+// period of the first meetup to the current one. Periods without meetups are included, for the
+// growth charts to have no gaps.
+func AggregateStatsByPeriod(cfg *types.Config, period types.StatsPeriod) ([]types.PeriodStats, error) {
+	if period != types.StatsPeriodYear && period != types.StatsPeriodQuarter && period != types.StatsPeriodMonth {
+		return nil, fmt.Errorf("unknown period %q, use %q, %q or %q", period, types.StatsPeriodYear, types.StatsPeriodQuarter, types.StatsPeriodMonth)
+	}
+	now := time.Now().UTC()
+	firsts := firstPresentations(cfg.MeetupGroups, now)
+	result := []types.PeriodStats{}
+	for _, start := range periods(pastMeetups(cfg.MeetupGroups, now), period, now) {
+		end := period.End(start)
+		ps := types.PeriodStats{
+			Period:    period.Name(start),
+			Start:     start,
+			End:       end,
+			PerMeetup: map[string]types.MeetupStats{},
+		}
+		to := end
+		if to.After(now) {
+			to = now
+		}
+		for _, mg := range cfg.MeetupGroups {
+			mgStat := meetupGroupStats(mg, start, to)
+			mgStat.SpeakerStats = speakerStats([]types.MeetupGroup{mg}, start, to, firsts)
+			ps.PerMeetup[mg.CityLowercase()] = mgStat
+			addStats(&ps.AllMeetups, mgStat)
+		}
+		ps.AllMeetups.AverageRSVPs = averageRSVPs(ps.AllMeetups)
+		ps.AllMeetups.SpeakerStats = speakerStats(cfg.MeetupGroups, start, to, firsts)
+		result = append(result, ps)
+	}
+	return result, nil
+}
+
+// meetupGroupStats computes the statistics of the meetups of the group in [from, to). If from is
+// zero, they are computed for all time, including the members and the sponsor tiers of the group.
+func meetupGroupStats(mg types.MeetupGroup, from, to time.Time) types.MeetupStats {
+	allTime := from.IsZero()
+	mgStat := types.MeetupStats{}
+	if allTime {
+		mgStat.SponsorByTier = map[types.SponsorTier]uint64{}
+		if mg.AutogenMeetupGroup != nil {
+			mgStat.Members = mg.Members
+		}
+	}
+	// uniqueRSVPs maps an user ID to the amount of RSVPs for that user
+	uniqueRSVPs := map[uint64]uint64{}
+	speakers := map[string]bool{}
+	sponsors := map[string]bool{}
+	for _, m := range mg.Meetups {
+		if m.AutogenMeetup == nil {
+			continue
+		}
+		date := m.Date.UTC()
+		if date.Before(from) || !date.Before(to) {
+			continue
+		}
+		mgStat.Meetups++
+		mgStat.TotalRSVPs += m.Attendees
+		for _, pres := range m.Presentations {
+			for _, s := range pres.Speakers {
+				speakers[string(s.RefID())] = true
+			}
+		}
+		for _, s := range m.Sponsors {
+			sponsors[string(s.Company.RefID())] = true
+		}
+
+		for userID, rsvpAmount := range m.RSVPs {
+			rsvps, ok := uniqueRSVPs[userID]
+			if ok {
+				// add the cumulatively the amount of guests (excluding the user, as they have already been counted)
+				uniqueRSVPs[userID] = rsvps + rsvpAmount - 1
+			} else {
+				// the first time, count the user itself, too
+				uniqueRSVPs[userID] = rsvpAmount
+			}
+		}
+	}
+
+	if allTime {
+		if mg.AutogenMeetupGroup != nil {
+			for _, tier := range mg.SponsorTiers {
+				mgStat.SponsorByTier[tier]++
+				if tier != types.SponsorTierEcosystemMember {
+					mgStat.Sponsors++
+				}
+			}
+		}
+	} else {
+		mgStat.Sponsors = uint64(len(sponsors))
+	}
+	mgStat.Speakers = uint64(len(speakers))
+	mgStat.AverageRSVPs = averageRSVPs(mgStat)
+	for _, num := range uniqueRSVPs {
+		mgStat.UniqueRSVPs += num
+	}
+	return mgStat
+}
+
+// addStats adds the statistics of a meetup group to the total
+func addStats(total *types.MeetupStats, s types.MeetupStats) {
+	total.Meetups += s.Meetups
+	total.Members += s.Members
+	total.TotalRSVPs += s.TotalRSVPs
+	total.UniqueRSVPs += s.UniqueRSVPs
+	total.Speakers += s.Speakers
+	total.Sponsors += s.Sponsors
+}
+
+func averageRSVPs(s types.MeetupStats) uint64 {
+	if s.Meetups == 0 {
+		return 0
+	}
+	return s.TotalRSVPs / s.Meetups
+}
+
+// periods returns the start of the periods from the first of the meetups, sorted by date, to now
+func periods(meetups []types.Meetup, period types.StatsPeriod, now time.Time) []time.Time {
+	result := []time.Time{}
+	if len(meetups) == 0 {
+		return result
+	}
+	for start := period.Start(meetups[0].Date.UTC()); !start.After(now); start = period.End(start) {
+		result = append(result, start)
+	}
+	return result
+}
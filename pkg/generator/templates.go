@@ -1,12 +1,79 @@
 package generator
 
-import "text/template"
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"text/template"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
 
-var (
-	readmeTmpl   = template.Must(template.New("").Parse(readmeTmplStr))
-	toplevelTmpl = template.Must(template.New("").Parse(toplevelTmplStr))
+const (
+	// GroupReadmeTemplate is executed with a types.MeetupGroup for the README.md of every meetup group
+	GroupReadmeTemplate = "group-readme.md.tmpl"
+	// ReadmeTemplate is executed with the types.Config for the toplevel README.md
+	ReadmeTemplate = "readme.md.tmpl"
+	// SpeakerTemplate is executed with a ProfileData for the profile of every speaker
+	SpeakerTemplate = "speaker.md.tmpl"
+	// CompanyTemplate is executed with a ProfileData for the profile of every company
+	CompanyTemplate = "company.md.tmpl"
 )
 
+// ProfileData is the data the speaker and company templates are executed with
+type ProfileData struct {
+	// Speaker is set for the speaker profiles
+	Speaker *types.Speaker
+	// Company is set for the company profiles
+	Company *types.Company
+	// Graph holds the relations to the meetups, e.g. the talks of a speaker
+	Graph *Graph
+}
+
+// DefaultTemplates maps the names of the templates to their compiled-in defaults
+var DefaultTemplates = map[string]string{
+	GroupReadmeTemplate: readmeTmplStr,
+	ReadmeTemplate:      toplevelTmplStr,
+	SpeakerTemplate:     speakerTmplStr,
+	CompanyTemplate:     companyTmplStr,
+}
+
+// LoadTemplates parses the default templates, and then all *.tmpl files in dir,
+// if it is set. A file named like one of the defaults overrides it, other files
+// can be used for sharing {{ define }} blocks between the templates.
+func LoadTemplates(dir string) (*template.Template, error) {
+	t := template.New("").Funcs(Funcs)
+	names := []string{}
+	for name := range DefaultTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := t.New(name).Parse(DefaultTemplates[name]); err != nil {
+			return nil, err
+		}
+	}
+	if len(dir) == 0 {
+		return t, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := t.New(filepath.Base(file)).Parse(string(b)); err != nil {
+			return nil, fmt.Errorf("couldn't parse template %s: %v", file, err)
+		}
+	}
+	return t, nil
+}
+
 const (
 	readmeTmplStr = `# Meetups organized in {{ .City }}
 
@@ -75,4 +142,49 @@ The **[meeting agenda](https://docs.google.com/document/d/1JxAZcNrGrK89-ErVOKku7
 is publicly available, please join the [#cloud-native-nordics](https://groups.google.com/forum/#!forum/cloud-native-nordics) mailing
 list to get write-access.
 `
+
+	speakerTmplStr = `# {{ .Speaker.Name }}
+{{ with .Speaker }}{{ if .Title }}
+{{ .Title }}{{ if .Company.Company }} at [{{ .Company.Name }}](../companies/{{ .Company.ID }}.md){{ end }}
+{{ else if .Company.Company }}
+Works at [{{ .Company.Name }}](../companies/{{ .Company.ID }}.md)
+{{ end }}{{ if .Github }}
+- GitHub: [@{{ .Github }}](https://github.com/{{ .Github }}){{ end }}{{ if .Twitter }}
+- Twitter: [@{{ .Twitter }}](https://twitter.com/{{ .Twitter }}){{ end }}{{ if .SpeakersBureau }}
+- [CNCF Speakers Bureau](https://www.cncf.io/speaker/{{ .SpeakersBureau }}){{ end }}
+{{ end }}{{ with .Graph.Organizing .Speaker.ID }}
+## Organizing
+
+{{ range . }}- [{{ .Name }}](../{{ .CityLowercase }}/README.md)
+{{ end }}{{ end }}
+## Presentations
+
+{{ range .Graph.Talks .Speaker.ID }}- {{ .Presentation.Title }}
+  - Meetup: {{ with .Meetup }}{{ if .AutogenMeetup }}{{ .Name }}, {{ date "2 January 2006" .Date }}{{ else }}{{ .Key }}{{ end }} in [{{ .Group.City }}](../{{ .Group.CityLowercase }}/README.md){{ end }}{{ if .Presentation.Slides }}
+  - Slides: {{ .Presentation.Slides }}{{ end }}{{ if .Presentation.Recording }}
+  - Recording: {{ .Presentation.Recording }}{{ else if .Meetup.Recording }}
+  - Recording: {{ .Meetup.Recording }}{{ end }}
+{{ else }}No presentations yet.
+{{ end }}`
+
+	companyTmplStr = `# {{ .Company.Name }}
+{{ with .Company }}{{ if .WebsiteURL }}
+{{ .WebsiteURL }}
+{{ end }}{{ end }}{{ with .Graph.SponsorTiers .Company.ID }}
+## Sponsor tiers
+
+{{ range . }}- [{{ .Group.Name }}](../{{ .Group.CityLowercase }}/README.md): {{ .Tier }}
+{{ end }}{{ end }}{{ with .Graph.EcosystemMemberOf .Company.ID }}
+## Ecosystem member of
+
+{{ range . }}- [{{ .Name }}](../{{ .CityLowercase }}/README.md)
+{{ end }}{{ end }}{{ range $role, $sponsorships := .Graph.SponsorshipsByRole .Company.ID }}
+## {{ $role }} sponsorships
+
+{{ range $sponsorships }}{{ with .Meetup }}- {{ if .AutogenMeetup }}{{ .Name }}, {{ date "2 January 2006" .Date }}{{ else }}{{ .Key }}{{ end }} in [{{ .Group.City }}](../{{ .Group.CityLowercase }}/README.md)
+{{ end }}{{ end }}{{ end }}{{ with .Graph.Employees .Company.ID }}
+## Speakers
+
+{{ range . }}- [{{ .Name }}](../speakers/{{ .ID }}.md)
+{{ end }}{{ end }}`
 )
@@ -0,0 +1,72 @@
+package generator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// countryTimeZones maps the lowercase names of the countries of the meetup groups to their time zones.
+// Groups in other countries have to set timeZone in meetup.yaml.
+var countryTimeZones = map[string]string{
+	"denmark":       "Europe/Copenhagen",
+	"estonia":       "Europe/Tallinn",
+	"faroe islands": "Atlantic/Faroe",
+	"finland":       "Europe/Helsinki",
+	"greenland":     "America/Nuuk",
+	"iceland":       "Atlantic/Reykjavik",
+	"latvia":        "Europe/Riga",
+	"lithuania":     "Europe/Vilnius",
+	"norway":        "Europe/Oslo",
+	"sweden":        "Europe/Stockholm",
+}
+
+// timeZoneAliases maps time zones to their former names, for systems with older time zone databases
+var timeZoneAliases = map[string]string{
+	"America/Nuuk": "America/Godthab",
+}
+
+// loadLocation loads the time zone, or else its former name
+func loadLocation(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		if alias, ok := timeZoneAliases[name]; ok {
+			if loc, aliasErr := time.LoadLocation(alias); aliasErr == nil {
+				return loc, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown time zone %q", name)
+	}
+	return loc, nil
+}
+
+// loadTimeZone checks the timeZone of a meetup group, if set, before anything is fetched
+func loadTimeZone(mg *types.MeetupGroup) error {
+	if len(mg.TimeZone) == 0 {
+		return nil
+	}
+	_, err := loadLocation(mg.TimeZone)
+	return err
+}
+
+// groupLocation returns the time zone of the meetups of a group: its timeZone if set, or else
+// the time zone of its country. The country can be given by name or by ISO code, in any case, as
+// the event sources return it. If the country is missing or unknown, UTC is used, with a warning.
+func groupLocation(humanGen types.MeetupGroup, country string) (*time.Location, error) {
+	name := humanGen.TimeZone
+	if len(name) == 0 {
+		key, _ := normalizeLocation(country, "")
+		name = countryTimeZones[key]
+	}
+	if len(name) == 0 {
+		if len(country) == 0 {
+			log.Warnf("Meetup group %q: the event source doesn't tell its country, using UTC. Set timeZone in meetup.yaml", humanGen.MeetupID)
+		} else {
+			log.Warnf("Meetup group %q: no time zone known for country %q, using UTC. Set timeZone in meetup.yaml", humanGen.MeetupID, country)
+		}
+		return time.UTC, nil
+	}
+	return loadLocation(name)
+}
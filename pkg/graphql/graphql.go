@@ -1,12 +1,14 @@
 package graphql
 
 import (
+	"encoding/json"
 	goflag "flag"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/99designs/gqlgen/handler"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/generator"
 	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/generated"
 	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/handlers"
 	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/repositories"
@@ -20,8 +22,16 @@ import (
 type Options struct {
 	// What port to serve GraphQL on
 	Port uint64
+	// MeetupsDir points to a local checkout of the meetups repository. If set, it is
+	// loaded like the generator does instead of the config.json file, see Meetups.
+	MeetupsDir string
+	// Meetups holds the options for loading MeetupsDir
+	Meetups generator.Options
 	// ConfigPath describes the location of the config.json file, can also be an URL
 	ConfigPath string
+	// ReloadInterval is how often to check the config.json URL for changes, 0 disables reloading.
+	// Local files are watched for changes instead.
+	ReloadInterval time.Duration
 	// SlackToken is the Slack token to produce invites
 	SlackToken string
 	// SlackURL is the URL to the Slack community
@@ -30,9 +40,15 @@ type Options struct {
 	SlackName string
 }
 
+// AddFlags adds the glog flags, like -v, to fs
+func AddFlags(fs *flag.FlagSet) {
+	fs.AddGoFlagSet(goflag.CommandLine)
+}
+
 func Serve(opts *Options) error {
-	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
-	flag.Parse()
+	// The glog flags are parsed as part of the command's flags, see AddFlags.
+	// Mark the Go flags as parsed, for glog to not complain about it.
+	goflag.CommandLine.Parse([]string{})
 
 	router := chi.NewRouter()
 
@@ -52,9 +68,18 @@ func Serve(opts *Options) error {
 	router.Use(middleware.Logger)
 	router.Use(middleware.Recoverer)
 
-	db := handlers.NewStatsManager(opts.ConfigPath)
+	sm := handlers.NewStatsManager(opts.ConfigPath, opts.ReloadInterval)
+	source := opts.ConfigPath
+	if len(opts.MeetupsDir) != 0 {
+		opts.Meetups.RootDir = opts.MeetupsDir
+		sm = handlers.NewStatsManagerForMeetupsDir(&opts.Meetups)
+		source = opts.MeetupsDir
+	}
+	if err := sm.Start(make(chan struct{})); err != nil {
+		return fmt.Errorf("could not start loading %s: %v", source, err)
+	}
 
-	statsRepo := repositories.NewStatsRepository(db)
+	statsRepo := repositories.NewStatsRepository(sm)
 	slackRepo := repositories.NewSlackRepository(opts.SlackToken, opts.SlackURL, opts.SlackName)
 
 	resolver := handlers.NewResolver(statsRepo, slackRepo)
@@ -65,9 +90,40 @@ func Serve(opts *Options) error {
 	router.Use(middleware.Timeout(5 * time.Second))
 
 	router.Handle("/", handler.Playground("GraphQL playground", "/query"))
-	router.Handle("/query", handler.GraphQL(generated.NewExecutableSchema(generated.Config{Resolvers: resolver})))
+	router.Handle("/query", snapshot(sm, handler.GraphQL(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))))
+	router.Get("/healthz", health(sm, false))
+	router.Get("/readyz", health(sm, true))
 
-	glog.V(5).Infof("Connect to http://localhost:%s/ for GraphQL playground", opts.Port)
+	glog.V(5).Infof("Connect to http://localhost:%d/ for GraphQL playground", opts.Port)
 	glog.Fatalf("Fatal: %s", http.ListenAndServe(fmt.Sprintf(":%d", opts.Port), router))
 	return nil
 }
+
+// snapshot makes all queries of a request use the database that was current when the request started.
+// Until the data is loaded, the requests fail with 503 Service Unavailable.
+func snapshot(sm *handlers.StatsManager, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		db := sm.DB()
+		if db == nil {
+			http.Error(w, "the data is not loaded yet", http.StatusServiceUnavailable)
+			return
+		}
+		ctx := repositories.WithDB(r.Context(), db)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// health reports the age of the data and the last reload error. If ready is true,
+// it fails until the data is loaded; otherwise it only reports that the server is up.
+func health(sm *handlers.StatsManager, ready bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status := sm.Status()
+		w.Header().Set("Content-Type", "application/json")
+		if ready && !status.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			glog.V(1).Info(err)
+		}
+	}
+}
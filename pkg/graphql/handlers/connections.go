@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/models"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/repositories"
+	"github.com/golang/glog"
+)
+
+//sortKeys hold the functions returning the keys of the nodes in the sort indexes of the connections, by table and order field.
+//The IDs break the ties, for the order to be stable.
+var sortKeys = map[string]map[string]func(obj interface{}) string{
+	"meetup": {
+		string(models.MeetupOrderFieldDate): func(obj interface{}) string {
+			m := obj.(models.Meetup)
+			return repositories.SortKey(m.Date, sortableInt(m.ID))
+		},
+		string(models.MeetupOrderFieldName): func(obj interface{}) string {
+			m := obj.(models.Meetup)
+			return repositories.SortKey(m.Name, m.Date, sortableInt(m.ID))
+		},
+		string(models.MeetupOrderFieldAttendees): func(obj interface{}) string {
+			m := obj.(models.Meetup)
+			return repositories.SortKey(sortableInt(m.Attendees), m.Date, sortableInt(m.ID))
+		},
+	},
+	"presentation": {
+		string(models.PresentationOrderFieldDate): func(obj interface{}) string {
+			p := obj.(models.Presentation)
+			return repositories.SortKey(p.Date, p.ID)
+		},
+		string(models.PresentationOrderFieldTitle): func(obj interface{}) string {
+			p := obj.(models.Presentation)
+			return repositories.SortKey(p.Title, p.Date, p.ID)
+		},
+	},
+	"speaker": {
+		string(models.SpeakerOrderFieldID): func(obj interface{}) string {
+			return repositories.SortKey(obj.(models.Speaker).ID)
+		},
+		string(models.SpeakerOrderFieldName): func(obj interface{}) string {
+			s := obj.(models.Speaker)
+			return repositories.SortKey(s.Name, s.ID)
+		},
+	},
+	"company": {
+		string(models.CompanyOrderFieldID): func(obj interface{}) string {
+			return repositories.SortKey(obj.(models.Company).ID)
+		},
+		string(models.CompanyOrderFieldName): func(obj interface{}) string {
+			c := obj.(models.Company)
+			return repositories.SortKey(c.Name, c.ID)
+		},
+	},
+}
+
+//sortableInt formats n for the keys to sort like the numbers, n must not be negative
+func sortableInt(n int) string {
+	return fmt.Sprintf("%020d", n)
+}
+
+//sortIndex returns the name of the sort index of the order field, in ascending order
+func sortIndex(field string) string {
+	return "order_" + strings.ToLower(field)
+}
+
+func (r *queryResolver) MeetupsConnection(ctx context.Context, first *int, after *string, last *int, before *string, filter *models.MeetupFilter, orderBy *models.MeetupOrder) (*models.MeetupConnection, error) {
+	listing, err := r.statsRepository.ListMeetups(ctx, filter)
+	if err != nil {
+		glog.V(1).Info(err)
+		return nil, err
+	}
+
+	field, desc := models.MeetupOrderFieldDate, false
+	if orderBy != nil {
+		field, desc = orderBy.Field, isDescending(orderBy.Direction)
+	}
+	nodes, cursors, pageInfo, total, err := r.page(ctx, listing, string(field), desc, first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+	connection := &models.MeetupConnection{Edges: []*models.MeetupEdge{}, PageInfo: pageInfo, TotalCount: total}
+	for i, obj := range nodes {
+		meetup := obj.(models.Meetup)
+		connection.Edges = append(connection.Edges, &models.MeetupEdge{Cursor: cursors[i], Node: &meetup})
+	}
+	return connection, nil
+}
+
+func (r *queryResolver) PresentationsConnection(ctx context.Context, first *int, after *string, last *int, before *string, filter *models.PresentationFilter, orderBy *models.PresentationOrder) (*models.PresentationConnection, error) {
+	listing, err := r.statsRepository.ListPresentations(ctx, filter)
+	if err != nil {
+		glog.V(1).Info(err)
+		return nil, err
+	}
+
+	field, desc := models.PresentationOrderFieldDate, false
+	if orderBy != nil {
+		field, desc = orderBy.Field, isDescending(orderBy.Direction)
+	}
+	nodes, cursors, pageInfo, total, err := r.page(ctx, listing, string(field), desc, first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+	connection := &models.PresentationConnection{Edges: []*models.PresentationEdge{}, PageInfo: pageInfo, TotalCount: total}
+	for i, obj := range nodes {
+		presentation := obj.(models.Presentation)
+		connection.Edges = append(connection.Edges, &models.PresentationEdge{Cursor: cursors[i], Node: &presentation})
+	}
+	return connection, nil
+}
+
+func (r *queryResolver) SpeakersConnection(ctx context.Context, first *int, after *string, last *int, before *string, filter *models.SpeakerFilter, orderBy *models.SpeakerOrder) (*models.SpeakerConnection, error) {
+	listing, err := r.statsRepository.ListSpeakers(ctx, filter)
+	if err != nil {
+		glog.V(1).Info(err)
+		return nil, err
+	}
+
+	field, desc := models.SpeakerOrderFieldID, false
+	if orderBy != nil {
+		field, desc = orderBy.Field, isDescending(orderBy.Direction)
+	}
+	nodes, cursors, pageInfo, total, err := r.page(ctx, listing, string(field), desc, first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+	connection := &models.SpeakerConnection{Edges: []*models.SpeakerEdge{}, PageInfo: pageInfo, TotalCount: total}
+	for i, obj := range nodes {
+		speaker := obj.(models.Speaker)
+		connection.Edges = append(connection.Edges, &models.SpeakerEdge{Cursor: cursors[i], Node: &speaker})
+	}
+	return connection, nil
+}
+
+func (r *queryResolver) CompaniesConnection(ctx context.Context, first *int, after *string, last *int, before *string, orderBy *models.CompanyOrder) (*models.CompanyConnection, error) {
+	field, desc := models.CompanyOrderFieldID, false
+	if orderBy != nil {
+		field, desc = orderBy.Field, isDescending(orderBy.Direction)
+	}
+	nodes, cursors, pageInfo, total, err := r.page(ctx, &repositories.Listing{Table: "company"}, string(field), desc, first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+	connection := &models.CompanyConnection{Edges: []*models.CompanyEdge{}, PageInfo: pageInfo, TotalCount: total}
+	for i, obj := range nodes {
+		company := obj.(models.Company)
+		connection.Edges = append(connection.Edges, &models.CompanyEdge{Cursor: cursors[i], Node: &company})
+	}
+	return connection, nil
+}
+
+func isDescending(direction *models.OrderDirection) bool {
+	return direction != nil && *direction == models.OrderDirectionDesc
+}
+
+//page returns the page of the listing in the order of the field for the pagination arguments, with the
+//cursors of the nodes and the number of nodes in the listing
+func (r *queryResolver) page(ctx context.Context, listing *repositories.Listing, field string, desc bool, first *int, after *string, last *int, before *string) ([]interface{}, []string, *models.PageInfo, int, error) {
+	listing.Index, listing.Desc, listing.Key = sortIndex(field), desc, sortKeys[listing.Table][field]
+	afterKey, err := decodeCursor(listing.Table, field, after)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	beforeKey, err := decodeCursor(listing.Table, field, before)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	nodes, pageInfo, total, err := r.statsRepository.Paginate(ctx, listing, first, afterKey, last, beforeKey)
+	if err != nil {
+		glog.V(1).Info(err)
+		return nil, nil, nil, 0, err
+	}
+
+	cursors := make([]string, len(nodes))
+	for i, obj := range nodes {
+		cursors[i] = encodeCursor(listing.Table, field, listing.Key(obj))
+	}
+	if len(cursors) != 0 {
+		pageInfo.StartCursor = &cursors[0]
+		pageInfo.EndCursor = &cursors[len(cursors)-1]
+	}
+	return nodes, cursors, pageInfo, total, nil
+}
+
+//encodeCursor returns the opaque cursor of a node from its key in the sort index of the order field.
+//The pages are found by seeking to the keys in the index, so the cursors stay valid when the data
+//is reloaded, even if the node is gone.
+func encodeCursor(kind, field, key string) string {
+	return base64.StdEncoding.EncodeToString([]byte(kind + ":" + field + ":" + key))
+}
+
+//decodeCursor returns the key in the sort index of the order field, the cursor must be of the same kind and order
+func decodeCursor(kind, field string, cursor *string) (*string, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(*cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %q", *cursor)
+	}
+	parts := strings.SplitN(string(b), ":", 3)
+	if len(parts) != 3 || parts[0] != kind || parts[1] != field {
+		return nil, fmt.Errorf("invalid cursor %q", *cursor)
+	}
+	return &parts[2], nil
+}
@@ -44,11 +44,14 @@ func (r *Resolver) Company() generated.CompanyResolver {
 func (r *Resolver) SponsorTier() generated.SponsorTierResolver {
 	return &sponsorTierResolver{r}
 }
+func (r *Resolver) SearchResult() generated.SearchResultResolver {
+	return &searchResultResolver{r}
+}
 
 type meetupResolver struct{ *Resolver }
 
 func (r *meetupResolver) Sponsors(ctx context.Context, obj *models.Meetup) ([]*models.Sponsor, error) {
-	sponsors, err := r.statsRepository.GetSponsorsForMeetup(obj.ID)
+	sponsors, err := r.statsRepository.GetSponsorsForMeetup(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -58,7 +61,7 @@ func (r *meetupResolver) Sponsors(ctx context.Context, obj *models.Meetup) ([]*m
 	return sponsors, nil
 }
 func (r *meetupResolver) Presentations(ctx context.Context, obj *models.Meetup) ([]*models.Presentation, error) {
-	presentations, err := r.statsRepository.GetPresentationsForMeetup(obj.ID)
+	presentations, err := r.statsRepository.GetPresentationsForMeetup(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -68,7 +71,7 @@ func (r *meetupResolver) Presentations(ctx context.Context, obj *models.Meetup)
 	return presentations, nil
 }
 func (r *meetupResolver) MeetupGroup(ctx context.Context, obj *models.Meetup) (*models.MeetupGroup, error) {
-	meetupGroup, err := r.statsRepository.GetMeetupGroupForMeetup(obj.ID)
+	meetupGroup, err := r.statsRepository.GetMeetupGroupForMeetup(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -81,7 +84,7 @@ func (r *meetupResolver) MeetupGroup(ctx context.Context, obj *models.Meetup) (*
 type meetupGroupResolver struct{ *Resolver }
 
 func (r *meetupGroupResolver) SponsorTiers(ctx context.Context, obj *models.MeetupGroup) ([]*models.SponsorTier, error) {
-	sponsorTiers, err := r.statsRepository.GetSponsorTiersForMeetupGroup(obj.MeetupID)
+	sponsorTiers, err := r.statsRepository.GetSponsorTiersForMeetupGroup(ctx, obj.MeetupID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -91,7 +94,7 @@ func (r *meetupGroupResolver) SponsorTiers(ctx context.Context, obj *models.Meet
 	return sponsorTiers, nil
 }
 func (r *meetupGroupResolver) Organizers(ctx context.Context, obj *models.MeetupGroup) ([]*models.Speaker, error) {
-	organizers, err := r.statsRepository.GetOrganizersForMeetupGroup(obj.MeetupID)
+	organizers, err := r.statsRepository.GetOrganizersForMeetupGroup(ctx, obj.MeetupID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -101,7 +104,7 @@ func (r *meetupGroupResolver) Organizers(ctx context.Context, obj *models.Meetup
 	return organizers, nil
 }
 func (r *meetupGroupResolver) EcosystemMembers(ctx context.Context, obj *models.MeetupGroup) ([]*models.Company, error) {
-	companies, err := r.statsRepository.GetEcosystemMembersForMeetupGroup(obj.MeetupID)
+	companies, err := r.statsRepository.GetEcosystemMembersForMeetupGroup(ctx, obj.MeetupID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -112,7 +115,7 @@ func (r *meetupGroupResolver) EcosystemMembers(ctx context.Context, obj *models.
 }
 
 func (r *meetupGroupResolver) Meetups(ctx context.Context, obj *models.MeetupGroup) ([]*models.Meetup, error) {
-	meetups, err := r.statsRepository.GetMeetupsForMeetupGroup(obj.MeetupID)
+	meetups, err := r.statsRepository.GetMeetupsForMeetupGroup(ctx, obj.MeetupID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -136,7 +139,7 @@ func (r *meetupGroupResolver) MemberCount(ctx context.Context, obj *models.Meetu
 type presentationResolver struct{ *Resolver }
 
 func (r *presentationResolver) Speakers(ctx context.Context, obj *models.Presentation) ([]*models.Speaker, error) {
-	speakers, err := r.statsRepository.GetSpeakersForPresentation(obj.ID)
+	speakers, err := r.statsRepository.GetSpeakersForPresentation(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -147,7 +150,7 @@ func (r *presentationResolver) Speakers(ctx context.Context, obj *models.Present
 }
 
 func (r *presentationResolver) Meetup(ctx context.Context, obj *models.Presentation) (*models.Meetup, error) {
-	meetup, err := r.statsRepository.GetMeetupForPresentation(obj.ID)
+	meetup, err := r.statsRepository.GetMeetupForPresentation(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -160,7 +163,7 @@ func (r *presentationResolver) Meetup(ctx context.Context, obj *models.Presentat
 type companyResolver struct{ *Resolver }
 
 func (r *companyResolver) Countries(ctx context.Context, obj *models.Company) ([]*string, error) {
-	countries, err := r.statsRepository.GetCountriesForCompany(obj.ID)
+	countries, err := r.statsRepository.GetCountriesForCompany(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -171,7 +174,7 @@ func (r *companyResolver) Countries(ctx context.Context, obj *models.Company) ([
 }
 
 func (r *companyResolver) SponsorTiers(ctx context.Context, obj *models.Company) ([]*models.SponsorTier, error) {
-	sponsorTiers, err := r.statsRepository.GetSponsorTiersForCompany(obj.ID)
+	sponsorTiers, err := r.statsRepository.GetSponsorTiersForCompany(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -182,7 +185,7 @@ func (r *companyResolver) SponsorTiers(ctx context.Context, obj *models.Company)
 }
 
 func (r *companyResolver) Speakers(ctx context.Context, obj *models.Company) ([]*models.Speaker, error) {
-	speakers, err := r.statsRepository.GetSpeakersForCompany(obj.ID)
+	speakers, err := r.statsRepository.GetSpeakersForCompany(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -195,7 +198,7 @@ func (r *companyResolver) Speakers(ctx context.Context, obj *models.Company) ([]
 type queryResolver struct{ *Resolver }
 
 func (r *queryResolver) MeetupGroups(ctx context.Context) ([]*models.MeetupGroup, error) {
-	meetupGroups, err := r.statsRepository.GetAllMeetupGroups()
+	meetupGroups, err := r.statsRepository.GetAllMeetupGroups(ctx)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -205,7 +208,7 @@ func (r *queryResolver) MeetupGroups(ctx context.Context) ([]*models.MeetupGroup
 	return meetupGroups, nil
 }
 func (r *queryResolver) MeetupGroup(ctx context.Context, meetupID string) (*models.MeetupGroup, error) {
-	meetupGroups, err := r.statsRepository.GetMeetupGroup(meetupID)
+	meetupGroups, err := r.statsRepository.GetMeetupGroup(ctx, meetupID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -215,7 +218,7 @@ func (r *queryResolver) MeetupGroup(ctx context.Context, meetupID string) (*mode
 	return meetupGroups, nil
 }
 func (r *queryResolver) Companies(ctx context.Context) ([]*models.Company, error) {
-	companies, err := r.statsRepository.GetAllCompanies()
+	companies, err := r.statsRepository.GetAllCompanies(ctx)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -225,7 +228,7 @@ func (r *queryResolver) Companies(ctx context.Context) ([]*models.Company, error
 	return companies, nil
 }
 func (r *queryResolver) Company(ctx context.Context, id string) (*models.Company, error) {
-	company, err := r.statsRepository.GetCompany(id)
+	company, err := r.statsRepository.GetCompany(ctx, id)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -235,7 +238,7 @@ func (r *queryResolver) Company(ctx context.Context, id string) (*models.Company
 	return company, nil
 }
 func (r *queryResolver) Meetups(ctx context.Context) ([]*models.Meetup, error) {
-	meetups, err := r.statsRepository.GetAllMeetups()
+	meetups, err := r.statsRepository.GetAllMeetups(ctx)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -245,7 +248,7 @@ func (r *queryResolver) Meetups(ctx context.Context) ([]*models.Meetup, error) {
 	return meetups, nil
 }
 func (r *queryResolver) Meetup(ctx context.Context, id int) (*models.Meetup, error) {
-	meetup, err := r.statsRepository.GetMeetup(id)
+	meetup, err := r.statsRepository.GetMeetup(ctx, id)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -255,7 +258,7 @@ func (r *queryResolver) Meetup(ctx context.Context, id int) (*models.Meetup, err
 	return meetup, nil
 }
 func (r *queryResolver) Presentations(ctx context.Context) ([]*models.Presentation, error) {
-	presentations, err := r.statsRepository.GetAllPresentations()
+	presentations, err := r.statsRepository.GetAllPresentations(ctx)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -265,7 +268,7 @@ func (r *queryResolver) Presentations(ctx context.Context) ([]*models.Presentati
 	return presentations, nil
 }
 func (r *queryResolver) Presentation(ctx context.Context, id string) (*models.Presentation, error) {
-	presentation, err := r.statsRepository.GetPresentation(id)
+	presentation, err := r.statsRepository.GetPresentation(ctx, id)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -275,7 +278,7 @@ func (r *queryResolver) Presentation(ctx context.Context, id string) (*models.Pr
 	return presentation, nil
 }
 func (r *queryResolver) Speakers(ctx context.Context) ([]*models.Speaker, error) {
-	speakers, err := r.statsRepository.GetAllSpeakers()
+	speakers, err := r.statsRepository.GetAllSpeakers(ctx)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -285,7 +288,7 @@ func (r *queryResolver) Speakers(ctx context.Context) ([]*models.Speaker, error)
 	return speakers, nil
 }
 func (r *queryResolver) Speaker(ctx context.Context, id string) (*models.Speaker, error) {
-	speaker, err := r.statsRepository.GetSpeaker(id)
+	speaker, err := r.statsRepository.GetSpeaker(ctx, id)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -303,7 +306,7 @@ func (r *queryResolver) SlackInvite(ctx context.Context, email string) (string,
 type speakerResolver struct{ *Resolver }
 
 func (r *speakerResolver) Company(ctx context.Context, obj *models.Speaker) (*models.Company, error) {
-	company, err := r.statsRepository.GetCompanyForSpeaker(obj.ID)
+	company, err := r.statsRepository.GetCompanyForSpeaker(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -314,7 +317,7 @@ func (r *speakerResolver) Company(ctx context.Context, obj *models.Speaker) (*mo
 }
 
 func (r *speakerResolver) Countries(ctx context.Context, obj *models.Speaker) ([]*string, error) {
-	countries, err := r.statsRepository.GetCountriesForSpeaker(obj.ID)
+	countries, err := r.statsRepository.GetCountriesForSpeaker(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -325,7 +328,7 @@ func (r *speakerResolver) Countries(ctx context.Context, obj *models.Speaker) ([
 }
 
 func (r *speakerResolver) Presentations(ctx context.Context, obj *models.Speaker) ([]*models.Presentation, error) {
-	presentations, err := r.statsRepository.GetPresentationsForSpeaker(obj.ID)
+	presentations, err := r.statsRepository.GetPresentationsForSpeaker(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -338,7 +341,7 @@ func (r *speakerResolver) Presentations(ctx context.Context, obj *models.Speaker
 type sponsorResolver struct{ *Resolver }
 
 func (r *sponsorResolver) Company(ctx context.Context, obj *models.Sponsor) (*models.Company, error) {
-	company, err := r.statsRepository.GetCompanyForSponsor(obj.ID)
+	company, err := r.statsRepository.GetCompanyForSponsor(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -351,7 +354,7 @@ func (r *sponsorResolver) Company(ctx context.Context, obj *models.Sponsor) (*mo
 type sponsorTierResolver struct{ *Resolver }
 
 func (r *sponsorTierResolver) Company(ctx context.Context, obj *models.SponsorTier) (*models.Company, error) {
-	company, err := r.statsRepository.GetCompanyForSponsorTier(obj.ID)
+	company, err := r.statsRepository.GetCompanyForSponsorTier(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
@@ -362,7 +365,7 @@ func (r *sponsorTierResolver) Company(ctx context.Context, obj *models.SponsorTi
 }
 
 func (r *sponsorTierResolver) MeetupGroups(ctx context.Context, obj *models.SponsorTier) ([]*models.MeetupGroup, error) {
-	meetupGroups, err := r.statsRepository.GetMeetupGroupsForSponsorTier(obj.ID)
+	meetupGroups, err := r.statsRepository.GetMeetupGroupsForSponsorTier(ctx, obj.ID)
 
 	if err != nil {
 		glog.V(1).Info(err)
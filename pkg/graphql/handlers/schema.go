@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/repositories"
 	"github.com/golang/glog"
 	"github.com/hashicorp/go-memdb"
 )
@@ -19,6 +20,18 @@ func createDatabaseSchema() *memdb.DBSchema {
 						Unique:  true,
 						Indexer: &memdb.StringFieldIndex{Field: "MeetupID"},
 					},
+					"country": {
+						Name:         "country",
+						Unique:       false,
+						AllowMissing: true,
+						Indexer:      &memdb.StringFieldIndex{Field: "Country", Lowercase: true},
+					},
+					"city": {
+						Name:         "city",
+						Unique:       false,
+						AllowMissing: true,
+						Indexer:      &memdb.StringFieldIndex{Field: "City", Lowercase: true},
+					},
 				},
 			},
 			//Meetup Schema
@@ -30,6 +43,12 @@ func createDatabaseSchema() *memdb.DBSchema {
 						Unique:  true,
 						Indexer: &memdb.IntFieldIndex{Field: "ID"},
 					},
+					// The dates are formatted as RFC3339 in UTC, so they sort chronologically
+					"date": {
+						Name:    "date",
+						Unique:  false,
+						Indexer: &memdb.StringFieldIndex{Field: "Date"},
+					},
 				},
 			},
 			//Company Schema
@@ -74,6 +93,16 @@ func createDatabaseSchema() *memdb.DBSchema {
 						Unique:  true,
 						Indexer: &memdb.StringFieldIndex{Field: "ID"},
 					},
+					"meetupID": {
+						Name:    "meetupID",
+						Unique:  false,
+						Indexer: &memdb.IntFieldIndex{Field: "MeetupID"},
+					},
+					"meetupGroupID": {
+						Name:    "meetupGroupID",
+						Unique:  false,
+						Indexer: &memdb.StringFieldIndex{Field: "MeetupGroupID"},
+					},
 				},
 			},
 			//Speaker Schema
@@ -297,7 +326,76 @@ func createDatabaseSchema() *memdb.DBSchema {
 					},
 				},
 			},
+			//Stats Schema
+			"stats": {
+				Name: "stats",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+					// The statistics for all time have no granularity, they are only found by ID
+					"granularity": {
+						Name:         "granularity",
+						Unique:       false,
+						AllowMissing: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "Scope"},
+								&memdb.StringFieldIndex{Field: "Granularity"},
+							},
+						},
+					},
+				},
+			},
+			//SearchIndex Schema, holds a single row
+			"searchIndex": {
+				Name: "searchIndex",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+				},
+			},
+			//SearchDocument Schema
+			"searchDocument": {
+				Name: "searchDocument",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+				},
+			},
+			//SearchTerm Schema, the inverted index of the search documents
+			"searchTerm": {
+				Name: "searchTerm",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+					"term": {
+						Name:    "term",
+						Unique:  false,
+						Indexer: &memdb.StringFieldIndex{Field: "Term"},
+					},
+				},
+			},
 		},
 	}
+	//The sort indexes of the connections, in both orders
+	for table, keys := range sortKeys {
+		for field, key := range keys {
+			index := sortIndex(field)
+			schema.Tables[table].Indexes[index] = &memdb.IndexSchema{Name: index, Unique: true, Indexer: &repositories.SortIndex{Key: key}}
+			schema.Tables[table].Indexes[index+"_desc"] = &memdb.IndexSchema{Name: index + "_desc", Unique: true, Indexer: &repositories.SortIndex{Key: key, Desc: true}}
+		}
+	}
 	return schema
 }
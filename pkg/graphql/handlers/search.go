@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/models"
+	"github.com/golang/glog"
+)
+
+func (r *queryResolver) Search(ctx context.Context, query string, types []models.SearchType, first *int) ([]*models.SearchResult, error) {
+	results, err := r.statsRepository.Search(ctx, query, types)
+	if err != nil {
+		glog.V(1).Info(err)
+		return nil, err
+	}
+
+	if first != nil && *first >= 0 && len(results) > *first {
+		results = results[:*first]
+	}
+	return results, nil
+}
+
+type searchResultResolver struct{ *Resolver }
+
+func (r *searchResultResolver) Presentation(ctx context.Context, obj *models.SearchResult) (*models.Presentation, error) {
+	if obj.Type != models.SearchTypePresentation {
+		return nil, nil
+	}
+	return r.statsRepository.GetPresentation(ctx, obj.ID)
+}
+
+func (r *searchResultResolver) Meetup(ctx context.Context, obj *models.SearchResult) (*models.Meetup, error) {
+	if obj.Type != models.SearchTypeMeetup {
+		return nil, nil
+	}
+	id, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return r.statsRepository.GetMeetup(ctx, id)
+}
+
+func (r *searchResultResolver) MeetupGroup(ctx context.Context, obj *models.SearchResult) (*models.MeetupGroup, error) {
+	if obj.Type != models.SearchTypeMeetupGroup {
+		return nil, nil
+	}
+	return r.statsRepository.GetMeetupGroup(ctx, obj.ID)
+}
+
+func (r *searchResultResolver) Speaker(ctx context.Context, obj *models.SearchResult) (*models.Speaker, error) {
+	if obj.Type != models.SearchTypeSpeaker {
+		return nil, nil
+	}
+	return r.statsRepository.GetSpeaker(ctx, obj.ID)
+}
+
+func (r *searchResultResolver) Company(ctx context.Context, obj *models.SearchResult) (*models.Company, error) {
+	if obj.Type != models.SearchTypeCompany {
+		return nil, nil
+	}
+	return r.statsRepository.GetCompany(ctx, obj.ID)
+}
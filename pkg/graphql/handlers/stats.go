@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/models"
+	"github.com/golang/glog"
+)
+
+func (r *queryResolver) Stats(ctx context.Context) (*models.Stats, error) {
+	return r.stats(ctx, models.StatsScopeAll)
+}
+
+func (r *queryResolver) StatsByPeriod(ctx context.Context, period models.StatsPeriod) ([]*models.PeriodStats, error) {
+	return r.statsByPeriod(ctx, models.StatsScopeAll, period)
+}
+
+func (r *meetupGroupResolver) Stats(ctx context.Context, obj *models.MeetupGroup) (*models.Stats, error) {
+	return r.stats(ctx, obj.MeetupID)
+}
+
+func (r *meetupGroupResolver) StatsByPeriod(ctx context.Context, obj *models.MeetupGroup, period models.StatsPeriod) ([]*models.PeriodStats, error) {
+	return r.statsByPeriod(ctx, obj.MeetupID, period)
+}
+
+func (r *Resolver) stats(ctx context.Context, scope string) (*models.Stats, error) {
+	stats, err := r.statsRepository.GetStats(ctx, scope)
+
+	if err != nil {
+		glog.V(1).Info(err)
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (r *Resolver) statsByPeriod(ctx context.Context, scope string, period models.StatsPeriod) ([]*models.PeriodStats, error) {
+	stats, err := r.statsRepository.GetStatsByPeriod(ctx, scope, strings.ToLower(string(period)))
+
+	if err != nil {
+		glog.V(1).Info(err)
+		return nil, err
+	}
+
+	output := make([]*models.PeriodStats, 0, len(stats))
+	for _, s := range stats {
+		output = append(output, &models.PeriodStats{Period: s.Period, Start: s.Start, End: s.End, Stats: s})
+	}
+	return output, nil
+}
@@ -1,28 +1,87 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
-	"io"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
-	"io/ioutil"
-
+	"github.com/cloud-native-nordics/meetup-kit/pkg/generator"
 	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/models"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/search"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/httpclient"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+	"github.com/fsnotify/fsnotify"
 	"github.com/golang/glog"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-memdb"
 )
 
-//StatsManager is responsible for managing the stats.json file generated in the meetups repository
+//StatsManager is responsible for managing the data of the meetups repository, either from the
+//generated config.json file or from a local checkout. It keeps the in-mem database up to date by
+//polling the URL or watching the local files, building a new database off to the side on changes
+//and atomically swapping it in.
 type StatsManager struct {
-	filepath string
-	URL      string
-	db       *memdb.MemDB
+	// source describes where the data is loaded from, e.g. the URL of the config.json file
+	source string
+	// load returns the configuration, or nil if it hasn't changed since the last load
+	load     func() (*types.Config, error)
+	interval time.Duration
+	// watchDirs are watched for changes to watchFiles or meetup.yaml files
+	watchDirs  []string
+	watchFiles map[string]bool
+	// watchRoot is the directory whose subdirectories are watched, too, including the ones created later
+	watchRoot string
+	// client fetches the config.json file if source is an URL
+	client *httpclient.Client
+	// withoutRSVPs is true when loading config.json, which doesn't contain the RSVPs of the meetups
+	// and the members of the meetup groups. These are taken from statsJSON instead, if available.
+	withoutRSVPs bool
+	statsJSON    *types.StatsFile
+	// db holds the current *memdb.MemDB, it is replaced on every reload
+	db atomic.Value
+
+	// reloadMux serializes the reloads, and guards the state of the last fetch
+	reloadMux    sync.Mutex
+	etag         string
+	lastModified string
+
+	// mux guards the status, it is never held while loading for the status to be available meanwhile
+	mux         sync.Mutex
+	loadedAt    time.Time
+	lastAttempt time.Time
+	lastErr     error
 }
 
+//Status describes the state of the data served by the StatsManager
+type Status struct {
+	Ready           bool      `json:"ready"`
+	Source          string    `json:"source"`
+	LoadedAt        time.Time `json:"loadedAt,omitempty"`
+	DataAge         string    `json:"dataAge,omitempty"`
+	LastAttempt     time.Time `json:"lastAttempt,omitempty"`
+	LastReloadError string    `json:"lastReloadError,omitempty"`
+}
+
+const (
+	// fetchTimeout bounds every attempt to fetch the config.json file, for a hung server not to block the reloads
+	fetchTimeout = 30 * time.Second
+	// fetchRetries is how often a failed fetch is retried before waiting for the next poll
+	fetchRetries = 3
+	// maxStartBackoff is the longest wait between attempts of the initial load
+	maxStartBackoff = time.Minute
+)
+
 type unmarshalledData struct {
 	companies                    []models.Company
 	speakers                     []models.Speaker
@@ -41,115 +100,421 @@ type unmarshalledData struct {
 	presentations                []models.Presentation
 	meetupToPresentation         []models.MeetupToPresentation
 	presentationToSpeaker        []models.PresentationToSpeaker
+	stats                        []models.Stats
+	searchDocuments              []models.SearchDocument
+	searchTerms                  []models.SearchTerm
 }
 
-type jsonStructure struct {
-	Companies    []models.CompanyIn     `json:"companies"`
-	Speakers     []models.SpeakerIn     `json:"speakers"`
-	MeetupGroups []models.MeetupGroupIn `json:"meetupGroups"`
+//NewStatsManager returns a StatsManager for the config.json file at source, which is either
+//an URL that is polled every interval, or a local path that is watched for changes.
+//An interval of 0 disables polling. Start must be called to load the data.
+func NewStatsManager(source string, interval time.Duration) *StatsManager {
+	sm := &StatsManager{
+		source:   source,
+		interval: interval,
+		// The config.json file isn't served by meetup.com, so it isn't rate limited like the Default client
+		client: httpclient.New(httpclient.Options{
+			Timeout:    fetchTimeout,
+			MaxRetries: fetchRetries,
+			MinBackoff: time.Second,
+			MaxBackoff: 10 * time.Second,
+		}),
+		withoutRSVPs: true,
+	}
+	sm.load = sm.loadConfigJSON
+	if !sm.isURL() {
+		sm.watchDirs = []string{filepath.Dir(source)}
+		sm.watchFiles = map[string]bool{
+			filepath.Clean(source):         true,
+			filepath.Clean(sm.statsPath()): true,
+		}
+	}
+	return sm
 }
 
-//NewStatsManager fetches the stats.json file, marshals to structs, creates in-mem db
-//and then returns a reference to the in-mem db.
-func NewStatsManager(URL string) *memdb.MemDB {
+//NewStatsManagerForMeetupsDir returns a StatsManager for a local checkout of the meetups
+//repository, loaded like the generator does. The YAML files are watched for changes.
+//Start must be called to load the data.
+func NewStatsManagerForMeetupsDir(opts *generator.Options) *StatsManager {
 	sm := &StatsManager{
-		URL:      URL,
-		filepath: "./data/stats.json",
+		source: opts.RootDir,
+		load: func() (*types.Config, error) {
+			cfg, problems, err := generator.Load(opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range problems {
+				glog.Warning(p)
+			}
+			return cfg, nil
+		},
+		watchDirs: []string{opts.RootDir, filepath.Dir(opts.CompaniesFile), filepath.Dir(opts.SpeakersFile)},
+		watchFiles: map[string]bool{
+			filepath.Clean(opts.CompaniesFile): true,
+			filepath.Clean(opts.SpeakersFile):  true,
+		},
+		watchRoot: filepath.Clean(opts.RootDir),
 	}
-	schema := createDatabaseSchema()
+	return sm
+}
 
-	err := sm.fetchStats()
+//Start loads the data in the background, and then keeps it up to date until stop is closed.
+//The initial load is retried until it succeeds, until then the Status isn't ready.
+//Errors when loading are reported in the Status, only failing to watch the local files is returned.
+func (sm *StatsManager) Start(stop <-chan struct{}) error {
+	if len(sm.watchDirs) != 0 {
+		if err := sm.watch(stop); err != nil {
+			return err
+		}
+	}
+	go func() {
+		if !sm.initialLoad(stop) {
+			return
+		}
+		if len(sm.watchDirs) == 0 && sm.interval > 0 {
+			sm.poll(stop)
+		}
+	}()
+	return nil
+}
 
-	if err != nil {
-		glog.Fatalf("Fatal could not fetch stats.json: %s", err)
+//initialLoad loads the data, retrying with an increasing backoff until it succeeds.
+//It returns false if stop is closed before that.
+func (sm *StatsManager) initialLoad(stop <-chan struct{}) bool {
+	backoff := time.Second
+	for sm.Reload() != nil {
+		select {
+		case <-time.After(backoff):
+		case <-stop:
+			return false
+		}
+		if backoff *= 2; backoff > maxStartBackoff {
+			backoff = maxStartBackoff
+		}
 	}
+	return true
+}
 
-	data, err := sm.unmarshallData()
+//DB returns the current in-mem database, or nil if the data isn't loaded yet
+func (sm *StatsManager) DB() *memdb.MemDB {
+	db, _ := sm.db.Load().(*memdb.MemDB)
+	return db
+}
 
-	if err != nil {
-		glog.Fatalf("Fatal could not unmarshall stats.json: %s", err)
-	}
+//Reload loads the data, and swaps in a new database if it has changed
+func (sm *StatsManager) Reload() error {
+	sm.reloadMux.Lock()
+	defer sm.reloadMux.Unlock()
+
+	sm.mux.Lock()
+	sm.lastAttempt = time.Now()
+	sm.mux.Unlock()
 
-	db, err := sm.populateDatabase(schema, data)
+	err := sm.reload()
 
+	sm.mux.Lock()
+	sm.lastErr = err
+	sm.mux.Unlock()
 	if err != nil {
-		glog.Fatalf("Fatal could not populate database: %s", err)
+		glog.Errorf("Could not reload %s: %v", sm.source, err)
 	}
-
-	return db
+	return err
 }
 
-//fetchStats downloads the stats.json from the supplied URL to a local file.
-func (sm *StatsManager) fetchStats() error {
-	glog.V(5).Infof("Fetching stats.json from: %s", sm.URL)
-	// Get the data
-	resp, err := http.Get(sm.URL)
+func (sm *StatsManager) reload() (err error) {
+	// A broken meetups repository must not take down the server, keep serving the previous data
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while loading: %v", r)
+		}
+	}()
 
+	cfg, err := sm.load()
 	if err != nil {
 		return err
 	}
+	if cfg == nil {
+		glog.V(5).Infof("%s is unchanged", sm.source)
+		return nil
+	}
 
-	if resp.StatusCode != 200 {
-		return errors.New(resp.Status)
+	data := sm.generateData(cfg)
+	db, err := sm.populateDatabase(createDatabaseSchema(), data)
+	if err != nil {
+		return fmt.Errorf("could not populate database: %v", err)
 	}
 
-	defer resp.Body.Close()
+	// Requests that already started keep using the previous database
+	sm.db.Store(db)
+	sm.mux.Lock()
+	sm.loadedAt = time.Now()
+	sm.mux.Unlock()
+	glog.V(1).Infof("Loaded %s", sm.source)
+	return nil
+}
 
-	// Create the dir if not present
-	workDir, _ := os.Getwd()
-	filesDir := filepath.Join(workDir, "data")
-	if _, err := os.Stat(filesDir); os.IsNotExist(err) {
-		glog.V(5).Infof("Creating data directory in %s", filesDir)
-		err = os.Mkdir(filesDir, os.ModePerm)
-		if err != nil {
-			glog.V(3).Infof("Couldn't create a new directory. %s", err.Error())
-			return err
-		}
+//Status returns the state of the data
+func (sm *StatsManager) Status() Status {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	status := Status{
+		Ready:       sm.DB() != nil,
+		Source:      sm.source,
+		LoadedAt:    sm.loadedAt,
+		LastAttempt: sm.lastAttempt,
+	}
+	if !sm.loadedAt.IsZero() {
+		status.DataAge = time.Since(sm.loadedAt).Round(time.Second).String()
 	}
+	if sm.lastErr != nil {
+		status.LastReloadError = sm.lastErr.Error()
+	}
+	return status
+}
+
+func (sm *StatsManager) isURL() bool {
+	return strings.HasPrefix(sm.source, "http://") || strings.HasPrefix(sm.source, "https://")
+}
 
-	// Create the file
-	out, err := os.Create(sm.filepath)
+//loadConfigJSON reads the config.json file, or returns nil if it hasn't changed since the last load
+func (sm *StatsManager) loadConfigJSON() (*types.Config, error) {
+	content, err := sm.fetchStats()
+	if err != nil || content == nil {
+		return nil, err
+	}
+	glog.V(5).Info("Unmarshalling config.json")
+	cfg := &types.Config{}
+	if err := json.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("could not unmarshall config.json: %v", err)
+	}
+	sm.statsJSON = sm.loadStatsJSON()
+	return cfg, nil
+}
+
+//statsPath returns the location of the stats.json file generated next to the config.json file
+func (sm *StatsManager) statsPath() string {
+	if !sm.isURL() {
+		return filepath.Join(filepath.Dir(sm.source), "stats.json")
+	}
+	u, err := url.Parse(sm.source)
 	if err != nil {
-		return err
+		return ""
 	}
-	defer out.Close()
+	return u.ResolveReference(&url.URL{Path: "stats.json"}).String()
+}
 
-	glog.V(5).Infof("Writing stats.json to: %s", sm.filepath)
-	// Write the body to file
-	_, err = io.Copy(out, resp.Body)
-	return err
+//loadStatsJSON reads the stats.json file for the statistics that can't be computed from config.json.
+//It returns nil if the file can't be read, and these statistics aren't served.
+func (sm *StatsManager) loadStatsJSON() *types.StatsFile {
+	path := sm.statsPath()
+	stats := &types.StatsFile{}
+	var err error
+	if sm.isURL() {
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+		defer cancel()
+		err = sm.client.GetJSON(ctx, path, stats)
+	} else {
+		var content []byte
+		if content, err = ioutil.ReadFile(path); err == nil {
+			err = json.Unmarshal(content, stats)
+		}
+	}
+	if err != nil {
+		glog.Warningf("Could not load %s, the members and unique RSVPs are not known: %v", path, err)
+		return nil
+	}
+	return stats
 }
 
-//Create models from json file
-func (sm *StatsManager) unmarshallData() (*unmarshalledData, error) {
-	var data jsonStructure
-	output := &unmarshalledData{}
+//fetchStats returns the content of the config.json file, or nil if it hasn't changed since the last fetch
+func (sm *StatsManager) fetchStats() ([]byte, error) {
+	if !sm.isURL() {
+		glog.V(5).Infof("Reading stats from: %s", sm.source)
+		return ioutil.ReadFile(sm.source)
+	}
 
-	file, err := ioutil.ReadFile(sm.filepath)
+	glog.V(5).Infof("Fetching stats from: %s", sm.source)
+	req, err := http.NewRequest(http.MethodGet, sm.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(sm.etag) != 0 {
+		req.Header.Set("If-None-Match", sm.etag)
+	}
+	if len(sm.lastModified) != 0 {
+		req.Header.Set("If-Modified-Since", sm.lastModified)
+	}
+	resp, err := sm.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	content, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
+	sm.etag = resp.Header.Get("ETag")
+	sm.lastModified = resp.Header.Get("Last-Modified")
+	return content, nil
+}
 
-	glog.V(5).Info("Unmarhsalling stats.json")
-	err = json.Unmarshal(file, &data)
+//poll reloads the data from the URL every interval
+func (sm *StatsManager) poll(stop <-chan struct{}) {
+	ticker := time.NewTicker(sm.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			sm.Reload()
+		case <-stop:
+			return
+		}
+	}
+}
 
+//watch reloads the data when the local files change. The directories are watched
+//instead of the files, for the changes to be noticed when a file is replaced.
+func (sm *StatsManager) watch(stop <-chan struct{}) error {
+	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
-		return nil, err
+		return err
 	}
+	watched := map[string]bool{}
+	for _, dir := range sm.watchDirs {
+		dir = filepath.Clean(dir)
+		if watched[dir] {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return err
+		}
+		watched[dir] = true
+	}
+	sm.watchMissing(watcher, watched)
+	glog.V(5).Infof("Watching %s for changes", sm.source)
 
-	sm.generateCompanies(output, data.Companies)
-	sm.generateSpeakers(output, data.Speakers)
+	go func() {
+		defer watcher.Close()
+		// Editors and generators write files in several steps, wait for them to settle
+		const settle = 500 * time.Millisecond
+		// Directories that are gone are looked for again after retry, unless other changes come first
+		const retry = 5 * time.Second
+		var reload, missing <-chan time.Time
+		for {
+			select {
+			case event := <-watcher.Events:
+				if len(event.Name) == 0 {
+					// The events of watches removed while they were being delivered don't have a name
+					continue
+				}
+				name := filepath.Clean(event.Name)
+				if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 && watched[name] {
+					// The directory isn't watched anymore, it is watched again once it is back
+					watcher.Remove(name)
+					delete(watched, name)
+					reload = time.After(settle)
+					continue
+				}
+				if event.Op&fsnotify.Create != 0 && sm.isWatchedSubdir(name) {
+					reload = time.After(settle)
+					continue
+				}
+				if !sm.watchFiles[name] && filepath.Base(name) != "meetup.yaml" {
+					continue
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) != 0 {
+					reload = time.After(settle)
+				}
+			case err := <-watcher.Errors:
+				glog.Errorf("Error watching %s: %v", sm.source, err)
+			case <-reload:
+				reload = nil
+				// The files written before the directories are watched are picked up by the reload
+				if !sm.watchMissing(watcher, watched) {
+					missing = time.After(retry)
+				}
+				sm.Reload()
+			case <-missing:
+				missing = nil
+				if sm.watchMissing(watcher, watched) {
+					sm.Reload()
+				} else {
+					missing = time.After(retry)
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return nil
+}
 
-	sm.generateMeetupGroups(output, data.MeetupGroups)
+//watchMissing watches the directories that aren't watched yet, as they were created, moved or recreated
+//since the start. It returns false if some of the watchDirs still don't exist.
+func (sm *StatsManager) watchMissing(watcher *fsnotify.Watcher, watched map[string]bool) bool {
+	dirs := append([]string{}, sm.watchDirs...)
+	if len(sm.watchRoot) != 0 {
+		if infos, err := ioutil.ReadDir(sm.watchRoot); err == nil {
+			for _, info := range infos {
+				if info.IsDir() && !strings.HasPrefix(info.Name(), ".") {
+					dirs = append(dirs, filepath.Join(sm.watchRoot, info.Name()))
+				}
+			}
+		}
+	}
+	complete := true
+	for i, dir := range dirs {
+		dir = filepath.Clean(dir)
+		if watched[dir] {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			// The subdirectories may be gone again, only the watchDirs must exist
+			if i < len(sm.watchDirs) {
+				glog.V(1).Infof("Can't watch %s yet: %v", dir, err)
+				complete = false
+			}
+			continue
+		}
+		glog.V(5).Infof("Watching %s for changes", dir)
+		watched[dir] = true
+	}
+	return complete
+}
 
-	return output, nil
+//isWatchedSubdir returns true if path is a subdirectory of the watchRoot that should be watched
+func (sm *StatsManager) isWatchedSubdir(path string) bool {
+	if len(sm.watchRoot) == 0 || filepath.Dir(path) != sm.watchRoot || strings.HasPrefix(filepath.Base(path), ".") {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+//Create models from the configuration
+func (sm *StatsManager) generateData(cfg *types.Config) *unmarshalledData {
+	output := &unmarshalledData{}
+	sm.generateCompanies(output, cfg.Companies)
+	sm.generateSpeakers(output, cfg.Speakers)
+	sm.generateMeetupGroups(output, cfg.MeetupGroups)
+	sm.generateStats(output, cfg)
+	sm.generateSearchIndex(output)
+	return output
 }
 
-func (sm *StatsManager) generateCompanies(output *unmarshalledData, companies []models.CompanyIn) {
+func (sm *StatsManager) generateCompanies(output *unmarshalledData, companies []types.Company) {
 	for _, company := range companies {
 		newCompany := &models.Company{
-			ID:         company.ID,
+			ID:         string(company.ID),
 			Name:       company.Name,
 			WebsiteURL: company.WebsiteURL,
 			LogoURL:    company.LogoURL,
@@ -159,51 +524,53 @@ func (sm *StatsManager) generateCompanies(output *unmarshalledData, companies []
 	}
 }
 
-func (sm *StatsManager) generateSpeakers(output *unmarshalledData, speakers []models.SpeakerIn) {
+func (sm *StatsManager) generateSpeakers(output *unmarshalledData, speakers []types.Speaker) {
 	for _, speaker := range speakers {
 		newSpeaker := &models.Speaker{
-			ID:             speaker.ID,
+			ID:             string(speaker.ID),
 			Name:           speaker.Name,
-			Title:          speaker.Title,
+			Title:          optionalString(speaker.Title),
 			Email:          speaker.Email,
 			Github:         speaker.Github,
-			Twitter:        speaker.Twitter,
+			Twitter:        optionalString(speaker.Twitter),
 			SpeakersBureau: speaker.SpeakersBureau,
 		}
 		output.speakers = append(output.speakers, *newSpeaker)
-		if speaker.Company != "" {
-			speakerToCompany := &models.SpeakerToCompany{ID: uuid.New().String(), SpeakerID: newSpeaker.ID, CompanyID: speaker.Company}
+		if companyID := speaker.Company.RefID(); companyID != "" {
+			speakerToCompany := &models.SpeakerToCompany{ID: uuid.New().String(), SpeakerID: newSpeaker.ID, CompanyID: string(companyID)}
 			output.speakerToCompany = append(output.speakerToCompany, *speakerToCompany)
 		}
 	}
 }
 
-func (sm *StatsManager) generateMeetupGroups(output *unmarshalledData, meetupGroups []models.MeetupGroupIn) {
+func (sm *StatsManager) generateMeetupGroups(output *unmarshalledData, meetupGroups []types.MeetupGroup) {
 	for _, group := range meetupGroups {
 		newMeetupGroup := &models.MeetupGroup{
-			Photo:       *group.Photo,
-			Name:        *group.Name,
-			City:        *group.City,
-			Country:     *group.Country,
-			Description: *group.Description,
-			MeetupID:    group.MeetupID,
-			CfpLink:     group.CfpLink,
-			Latitude:    group.Latitude,
-			Longitude:   group.Longitude,
+			MeetupID:  group.MeetupID,
+			CfpLink:   group.CFP,
+			Latitude:  group.Latitude,
+			Longitude: group.Longitude,
+		}
+		if group.AutogenMeetupGroup != nil {
+			newMeetupGroup.Photo = group.Photo
+			newMeetupGroup.Name = group.Name
+			newMeetupGroup.City = group.City
+			newMeetupGroup.Country = group.Country
+			newMeetupGroup.Description = group.Description
+			sm.generateSponsorTiers(output, group.SponsorTiers, group.MeetupID)
 		}
 		output.meetupGroups = append(output.meetupGroups, *newMeetupGroup)
-		sm.generateSponsorTiers(output, group.SponsorTiers, group.MeetupID)
 		sm.generateOrganizers(output, group.Organizers, group.MeetupID)
 		sm.generateEcosystemMembers(output, group.EcosystemMembers, group.MeetupID)
 		sm.generateMeetups(output, group.Meetups, group.MeetupID)
 	}
 }
 
-func (sm *StatsManager) generateSponsorTiers(output *unmarshalledData, sponsorTiers map[string]string, meetupGroupID string) {
+func (sm *StatsManager) generateSponsorTiers(output *unmarshalledData, sponsorTiers map[types.CompanyID]types.SponsorTier, meetupGroupID string) {
 	for company, tier := range sponsorTiers {
 		newSponsorTier := &models.SponsorTier{
-			ID:   uuid.New().String(),
-			Tier: tier,
+			ID:   sponsorTierID(meetupGroupID, company),
+			Tier: string(tier),
 		}
 		output.sponsorTiers = append(output.sponsorTiers, *newSponsorTier)
 		newSponsorTierToMeetupGroup := &models.SponsorTierToMeetupGroup{
@@ -215,43 +582,48 @@ func (sm *StatsManager) generateSponsorTiers(output *unmarshalledData, sponsorTi
 		newSponsorTierToCompany := &models.SponsorTierToCompany{
 			ID:            uuid.New().String(),
 			SponsorTierID: newSponsorTier.ID,
-			CompanyID:     company,
+			CompanyID:     string(company),
 		}
 		output.sponsorTierToCompany = append(output.sponsorTierToCompany, *newSponsorTierToCompany)
 	}
 }
 
-func (sm *StatsManager) generateOrganizers(output *unmarshalledData, organizers []string, meetupGroupID string) {
+func (sm *StatsManager) generateOrganizers(output *unmarshalledData, organizers []types.SpeakerRef, meetupGroupID string) {
 	for _, organizer := range organizers {
 		newMeetupGroupToOrganizer := &models.MeetupGroupToOrganizer{
 			ID:            uuid.New().String(),
 			MeetupGroupID: meetupGroupID,
-			OrganizerID:   organizer,
+			OrganizerID:   string(organizer.RefID()),
 		}
 		output.meetupGroupToOrganizer = append(output.meetupGroupToOrganizer, *newMeetupGroupToOrganizer)
 	}
 }
 
-func (sm *StatsManager) generateEcosystemMembers(output *unmarshalledData, members []string, meetupGroupID string) {
+func (sm *StatsManager) generateEcosystemMembers(output *unmarshalledData, members []types.CompanyRef, meetupGroupID string) {
 	for _, member := range members {
 		newMeetupGroupToEcosystemMember := &models.MeetupGroupToEcosystemMember{
 			ID:            uuid.New().String(),
 			MeetupGroupID: meetupGroupID,
-			CompanyID:     member,
+			CompanyID:     string(member.RefID()),
 		}
 		output.meetupGroupToEcosystemMember = append(output.meetupGroupToEcosystemMember, *newMeetupGroupToEcosystemMember)
 	}
 }
 
-func (sm *StatsManager) generateMeetups(output *unmarshalledData, meetups map[string]*models.MeetupIn, meetupGroupID string) {
-	for _, meetup := range meetups {
+func (sm *StatsManager) generateMeetups(output *unmarshalledData, meetups map[string]types.Meetup, meetupGroupID string) {
+	for key, meetup := range meetups {
+		if meetup.AutogenMeetup == nil {
+			// Without the autogenerated data the meetup has no ID
+			glog.V(3).Infof("Skipping meetup %s of %s, it doesn't exist on meetup.com", key, meetupGroupID)
+			continue
+		}
 		newMeetup := &models.Meetup{
-			ID:        meetup.ID,
+			ID:        int(meetup.ID),
 			Name:      meetup.Name,
-			Date:      meetup.Date,
-			Duration:  meetup.Duration,
+			Date:      meetup.Date.UTC().Format(time.RFC3339),
+			Duration:  meetup.Duration.String(),
 			Photo:     meetup.Photo,
-			Attendees: meetup.Attendees,
+			Attendees: int(meetup.Attendees),
 			Address:   meetup.Address,
 			Recording: meetup.Recording,
 		}
@@ -263,17 +635,23 @@ func (sm *StatsManager) generateMeetups(output *unmarshalledData, meetups map[st
 		}
 		output.meetupGroupToMeetup = append(output.meetupGroupToMeetup, *newMeetupGroupToMeetup)
 
-		sm.generateSponsors(output, meetup.Sponsors, meetup.ID)
-		sm.generatePresentations(output, meetup.Presentations, meetup.ID)
+		sm.generateSponsors(output, meetup.Sponsors, newMeetup.ID)
+		sm.generatePresentations(output, meetup.Presentations, newMeetup, meetupGroupID)
 	}
 }
 
-func (sm *StatsManager) generateSponsors(output *unmarshalledData, sponsors []*models.SponsorIn, meetupID int) {
+func (sm *StatsManager) generateSponsors(output *unmarshalledData, sponsors []types.MeetupSponsor, meetupID int) {
+	seen := map[string]bool{}
 	for _, sponsor := range sponsors {
 		newSponsor := &models.Sponsor{
-			ID:   uuid.New().String(),
-			Role: sponsor.Role,
+			ID:   sponsorID(meetupID, sponsor),
+			Role: string(sponsor.Role),
+		}
+		if seen[newSponsor.ID] {
+			glog.Warningf("Skipping duplicate sponsor %s", newSponsor.ID)
+			continue
 		}
+		seen[newSponsor.ID] = true
 		output.sponsors = append(output.sponsors, *newSponsor)
 		newMeetupToSponsor := &models.MeetupToSponsor{
 			ID:        uuid.New().String(),
@@ -284,20 +662,30 @@ func (sm *StatsManager) generateSponsors(output *unmarshalledData, sponsors []*m
 		newSponsorToCompany := &models.SponsorToCompany{
 			ID:        uuid.New().String(),
 			SponsorID: newSponsor.ID,
-			CompanyID: sponsor.Company,
+			CompanyID: string(sponsor.Company.RefID()),
 		}
 		output.sponsorToCompany = append(output.sponsorToCompany, *newSponsorToCompany)
 	}
 }
 
-func (sm *StatsManager) generatePresentations(output *unmarshalledData, presentations []*models.PresentationIn, meetupID int) {
-	for _, presentation := range presentations {
+func (sm *StatsManager) generatePresentations(output *unmarshalledData, presentations []types.Presentation, meetup *models.Meetup, meetupGroupID string) {
+	meetupID := meetup.ID
+	seen := map[string]bool{}
+	for i, presentation := range presentations {
 		newPresentation := &models.Presentation{
-			ID:       uuid.New().String(),
-			Duration: presentation.Duration,
-			Title:    presentation.Title,
-			Slides:   presentation.Slides,
+			ID:            presentationID(meetupID, i, presentation),
+			Duration:      presentation.Duration.String(),
+			Title:         presentation.Title,
+			Slides:        presentation.Slides,
+			MeetupID:      meetupID,
+			MeetupGroupID: meetupGroupID,
+			Date:          meetup.Date,
+		}
+		if seen[newPresentation.ID] {
+			glog.Warningf("Skipping presentation with duplicate ID %s", newPresentation.ID)
+			continue
 		}
+		seen[newPresentation.ID] = true
 		output.presentations = append(output.presentations, *newPresentation)
 		newMeetupToPresentation := &models.MeetupToPresentation{
 			ID:             uuid.New().String(),
@@ -310,13 +698,208 @@ func (sm *StatsManager) generatePresentations(output *unmarshalledData, presenta
 			newPresentationToSpeaker := &models.PresentationToSpeaker{
 				ID:             uuid.New().String(),
 				PresentationID: newPresentation.ID,
-				SpeakerID:      *speaker,
+				SpeakerID:      string(speaker.RefID()),
 			}
 			output.presentationToSpeaker = append(output.presentationToSpeaker, *newPresentationToSpeaker)
 		}
 	}
 }
 
+//generateStats aggregates the statistics of all meetup groups and of each of them, for all time and per period
+func (sm *StatsManager) generateStats(output *unmarshalledData, cfg *types.Config) {
+	stats, _ := generator.AggregateStats(cfg)
+	meetupGroups := int(stats.MeetupGroups)
+	// stats.json doesn't sum up the sponsor tiers of the meetup groups
+	stats.AllMeetups.SponsorByTier = map[types.SponsorTier]uint64{}
+	for _, mgStats := range stats.PerMeetup {
+		for tier, count := range mgStats.SponsorByTier {
+			stats.AllMeetups.SponsorByTier[tier] += count
+		}
+	}
+	all := newStats(models.StatsScopeAll, "", stats.AllMeetups)
+	all.MeetupGroups = &meetupGroups
+	if sm.withoutRSVPs {
+		setRSVPStats(&all, sm.precomputedStats(""))
+	}
+	output.stats = append(output.stats, all)
+	for _, mg := range cfg.MeetupGroups {
+		mgStats := newStats(mg.MeetupID, "", stats.PerMeetup[mg.CityLowercase()])
+		if sm.withoutRSVPs {
+			setRSVPStats(&mgStats, sm.precomputedStats(mg.CityLowercase()))
+		}
+		output.stats = append(output.stats, mgStats)
+	}
+
+	for _, granularity := range []types.StatsPeriod{types.StatsPeriodYear, types.StatsPeriodQuarter} {
+		periods, err := generator.AggregateStatsByPeriod(cfg, granularity)
+		if err != nil {
+			glog.Errorf("Could not aggregate the statistics per %s: %v", granularity, err)
+			continue
+		}
+		for _, period := range periods {
+			periodStats := []models.Stats{newStats(models.StatsScopeAll, granularity, period.AllMeetups)}
+			for _, mg := range cfg.MeetupGroups {
+				periodStats = append(periodStats, newStats(mg.MeetupID, granularity, period.PerMeetup[mg.CityLowercase()]))
+			}
+			for _, s := range periodStats {
+				if sm.withoutRSVPs {
+					// stats.json doesn't have the unique RSVPs per period
+					setRSVPStats(&s, nil)
+				}
+				s.ID += ":" + period.Period
+				s.Period = period.Period
+				s.Start = period.Start.Format(time.RFC3339)
+				s.End = period.End.Format(time.RFC3339)
+				output.stats = append(output.stats, s)
+			}
+		}
+	}
+}
+
+func newStats(scope string, granularity types.StatsPeriod, s types.MeetupStats) models.Stats {
+	stats := models.Stats{
+		ID:             fmt.Sprintf("%s:%s", scope, granularity),
+		Scope:          scope,
+		Granularity:    string(granularity),
+		Meetups:        int(s.Meetups),
+		Sponsors:       int(s.Sponsors),
+		SponsorsByTier: []*models.SponsorTierCount{},
+		Speakers:       int(s.Speakers),
+		TotalRSVPs:     int(s.TotalRSVPs),
+		AverageRSVPs:   int(s.AverageRSVPs),
+	}
+	uniqueRSVPs := int(s.UniqueRSVPs)
+	stats.UniqueRSVPs = &uniqueRSVPs
+	if len(granularity) == 0 {
+		members := int(s.Members)
+		stats.Members = &members
+	}
+	tiers := make([]string, 0, len(s.SponsorByTier))
+	for tier := range s.SponsorByTier {
+		tiers = append(tiers, string(tier))
+	}
+	sort.Strings(tiers)
+	for _, tier := range tiers {
+		stats.SponsorsByTier = append(stats.SponsorsByTier, &models.SponsorTierCount{Tier: tier, Count: int(s.SponsorByTier[types.SponsorTier(tier)])})
+	}
+	return stats
+}
+
+//precomputedStats returns the statistics of the meetup group in city from stats.json, or of all
+//meetup groups if city is empty. It returns nil if stats.json isn't loaded.
+func (sm *StatsManager) precomputedStats(city string) *types.MeetupStats {
+	if sm.statsJSON == nil {
+		return nil
+	}
+	if len(city) == 0 {
+		return &sm.statsJSON.AllMeetups
+	}
+	s, ok := sm.statsJSON.PerMeetup[city]
+	if !ok {
+		return nil
+	}
+	return &s
+}
+
+//setRSVPStats sets the statistics that can't be computed without the RSVPs and the members, from
+//precomputed statistics. If these aren't available, the statistics are left unset instead of zero.
+func setRSVPStats(stats *models.Stats, precomputed *types.MeetupStats) {
+	stats.UniqueRSVPs = nil
+	if stats.Members != nil {
+		stats.Members = nil
+		if precomputed != nil {
+			members := int(precomputed.Members)
+			stats.Members = &members
+		}
+	}
+	if precomputed != nil {
+		uniqueRSVPs := int(precomputed.UniqueRSVPs)
+		stats.UniqueRSVPs = &uniqueRSVPs
+	}
+}
+
+// Weights of the fields in the search ranking
+const (
+	searchWeightName        = 3.0
+	searchWeightTitle       = 1.5
+	searchWeightDescription = 1.0
+)
+
+//generateSearchIndex builds the inverted index of the text of the nodes
+func (sm *StatsManager) generateSearchIndex(output *unmarshalledData) {
+	add := func(searchType models.SearchType, nodeID, field, text string, weight float64) {
+		if len(text) == 0 {
+			return
+		}
+		document := models.SearchDocument{
+			ID:     fmt.Sprintf("%s:%s:%s", searchType, nodeID, field),
+			Type:   string(searchType),
+			NodeID: nodeID,
+			Field:  field,
+			Text:   text,
+			Weight: weight,
+		}
+		output.searchDocuments = append(output.searchDocuments, document)
+
+		counts := map[string]int{}
+		for _, term := range search.Tokenize(text) {
+			counts[term]++
+		}
+		for term, count := range counts {
+			output.searchTerms = append(output.searchTerms, models.SearchTerm{ID: document.ID + ":" + term, Term: term, DocumentID: document.ID, Count: count})
+		}
+	}
+
+	for _, p := range output.presentations {
+		add(models.SearchTypePresentation, p.ID, "title", p.Title, searchWeightName)
+	}
+	for _, m := range output.meetups {
+		add(models.SearchTypeMeetup, strconv.Itoa(m.ID), "name", m.Name, searchWeightName)
+	}
+	for _, mg := range output.meetupGroups {
+		add(models.SearchTypeMeetupGroup, mg.MeetupID, "name", mg.Name, searchWeightName)
+		add(models.SearchTypeMeetupGroup, mg.MeetupID, "description", search.StripHTML(mg.Description), searchWeightDescription)
+	}
+	for _, s := range output.speakers {
+		add(models.SearchTypeSpeaker, s.ID, "name", s.Name, searchWeightName)
+		if s.Title != nil {
+			add(models.SearchTypeSpeaker, s.ID, "title", *s.Title, searchWeightTitle)
+		}
+	}
+	for _, c := range output.companies {
+		add(models.SearchTypeCompany, c.ID, "name", c.Name, searchWeightName)
+	}
+}
+
+// The IDs of the presentations, sponsors and sponsor tiers are derived from the content, for them
+// to be stable across reloads and restarts. The relations only use random IDs, they aren't exposed.
+
+// presentationID returns the ID of the i-th presentation of the meetup, e.g. "276754274-2".
+// If the presentation has an explicit ID, it is used instead of its position, e.g. "276754274-intro".
+func presentationID(meetupID int, i int, p types.Presentation) string {
+	if len(p.ID) != 0 {
+		return fmt.Sprintf("%d-%s", meetupID, p.ID)
+	}
+	return fmt.Sprintf("%d-%d", meetupID, i+1)
+}
+
+// sponsorID returns the ID of the sponsor of the meetup, e.g. "276754274-acme-Venue"
+func sponsorID(meetupID int, s types.MeetupSponsor) string {
+	return fmt.Sprintf("%d-%s-%s", meetupID, s.Company.RefID(), s.Role)
+}
+
+// sponsorTierID returns the ID of the sponsor tier of the company in the meetup group, e.g. "Cloud-Native-Stockholm-acme"
+func sponsorTierID(meetupGroupID string, company types.CompanyID) string {
+	return fmt.Sprintf("%s-%s", meetupGroupID, company)
+}
+
+func optionalString(s string) *string {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s
+}
+
 //Create and populate database
 func (sm *StatsManager) populateDatabase(schema *memdb.DBSchema, data *unmarshalledData) (*memdb.MemDB, error) {
 	// Create a new data base
@@ -464,6 +1047,30 @@ func (sm *StatsManager) populateDatabase(schema *memdb.DBSchema, data *unmarshal
 			return nil, err
 		}
 	}
+
+	// Insert Stats
+	glog.V(5).Infof("Inserting %d Stats", len(data.stats))
+	for _, stats := range data.stats {
+		if err := txn.Insert("stats", stats); err != nil {
+			return nil, err
+		}
+	}
+
+	// Insert the search index
+	glog.V(5).Infof("Inserting %d Search Documents with %d Terms", len(data.searchDocuments), len(data.searchTerms))
+	for _, document := range data.searchDocuments {
+		if err := txn.Insert("searchDocument", document); err != nil {
+			return nil, err
+		}
+	}
+	for _, term := range data.searchTerms {
+		if err := txn.Insert("searchTerm", term); err != nil {
+			return nil, err
+		}
+	}
+	if err := txn.Insert("searchIndex", models.SearchIndex{ID: models.SearchIndexID, Documents: len(data.searchDocuments)}); err != nil {
+		return nil, err
+	}
 	// Commit the transaction
 	txn.Commit()
 
@@ -1,65 +1,5 @@
 package models
 
-type CompanyIn struct {
-	ID         string `json:"id"`
-	Name       string `json:"name"`
-	WebsiteURL string `json:"websiteURL"`
-	LogoURL    string `json:"logoURL"`
-	WhiteLogo  bool   `json:"whiteLogo"`
-}
-
-type SpeakerIn struct {
-	ID             string  `json:"id"`
-	Name           string  `json:"name"`
-	Title          *string `json:"title"`
-	Email          string  `json:"email"`
-	Company        string  `json:"company"`
-	Github         string  `json:"github"`
-	Twitter        *string `json:"twitter"`
-	SpeakersBureau string  `json:"speakersBureau"`
-}
-
-type MeetupGroupIn struct {
-	Photo            *string              `json:"photo"`
-	Name             *string              `json:"name"`
-	City             *string              `json:"city"`
-	Country          *string              `json:"country"`
-	Description      *string              `json:"description"`
-	SponsorTiers     map[string]string    `json:"sponsorTiers"`
-	MeetupID         string               `json:"meetupID"`
-	Organizers       []string             `json:"organizers"`
-	CfpLink          string               `json:"cfpLink"`
-	Latitude         float64              `json:"latitude"`
-	Longitude        float64              `json:"longitude"`
-	EcosystemMembers []string             `json:"ecosystemMembers"`
-	Meetups          map[string]*MeetupIn `json:"meetups"`
-}
-
-type MeetupIn struct {
-	ID            int               `json:"id"`
-	Name          string            `json:"name"`
-	Date          string            `json:"date"`
-	Duration      string            `json:"duration"`
-	Attendees     int               `json:"attendees"`
-	Address       string            `json:"address"`
-	Photo         string            `json:"photo"`
-	Recording     string            `json:"recording"`
-	Sponsors      []*SponsorIn      `json:"sponsors"`
-	Presentations []*PresentationIn `json:"presentations"`
-}
-
-type SponsorIn struct {
-	Company string `json:"company"`
-	Role    string `json:"role"`
-}
-
-type PresentationIn struct {
-	Duration string    `json:"duration"`
-	Title    string    `json:"title"`
-	Slides   string    `json:"slides"`
-	Speakers []*string `json:"speakers"`
-}
-
 type MeetupGroup struct {
 	Photo       string
 	Name        string
@@ -106,6 +46,10 @@ type Presentation struct {
 	Duration string
 	Title    string
 	Slides   string
+	//The meetup of the presentation, copied to index the presentations by it
+	MeetupID      int
+	MeetupGroupID string
+	Date          string
 }
 
 type Speaker struct {
@@ -118,6 +62,38 @@ type Speaker struct {
 	SpeakersBureau string
 }
 
+type SearchResult struct {
+	Type    SearchType
+	ID      string
+	Score   float64
+	Field   string
+	Snippet string
+}
+
+//StatsScopeAll is the scope of the statistics of all meetup groups
+const StatsScopeAll = "*"
+
+//Stats holds the statistics of a meetup group or of all of them, for all time or for a period
+type Stats struct {
+	ID string
+	//Scope is the ID of the meetup group, or StatsScopeAll
+	Scope string
+	//Granularity is the length of the period, empty for all time
+	Granularity    string
+	Period         string
+	Start          string
+	End            string
+	MeetupGroups   *int
+	Meetups        int
+	Members        *int
+	Sponsors       int
+	SponsorsByTier []*SponsorTierCount
+	Speakers       int
+	TotalRSVPs     int
+	AverageRSVPs   int
+	UniqueRSVPs    *int
+}
+
 //Mapping Tables
 type SpeakerToCompany struct {
 	ID        string
@@ -178,3 +154,29 @@ type PresentationToSpeaker struct {
 	PresentationID string
 	SpeakerID      string
 }
+
+//Search Index
+type SearchDocument struct {
+	ID     string
+	Type   string
+	NodeID string
+	Field  string
+	Text   string
+	Weight float64
+}
+
+type SearchTerm struct {
+	ID         string
+	Term       string
+	DocumentID string
+	Count      int
+}
+
+//SearchIndexID is the ID of the only SearchIndex
+const SearchIndexID = "*"
+
+//SearchIndex holds the figures of the whole search index, they are computed once when it is built
+type SearchIndex struct {
+	ID        string
+	Documents int
+}
@@ -0,0 +1,406 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type CompanyConnection struct {
+	Edges      []*CompanyEdge `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+type CompanyEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Company `json:"node"`
+}
+
+type CompanyOrder struct {
+	Field     CompanyOrderField `json:"field"`
+	Direction *OrderDirection   `json:"direction"`
+}
+
+type MeetupConnection struct {
+	Edges      []*MeetupEdge `json:"edges"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+type MeetupEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Meetup `json:"node"`
+}
+
+type MeetupFilter struct {
+	From        *string `json:"from"`
+	To          *string `json:"to"`
+	Country     *string `json:"country"`
+	City        *string `json:"city"`
+	MeetupGroup *string `json:"meetupGroup"`
+	Sponsor     *string `json:"sponsor"`
+}
+
+type MeetupOrder struct {
+	Field     MeetupOrderField `json:"field"`
+	Direction *OrderDirection  `json:"direction"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type PeriodStats struct {
+	Period string `json:"period"`
+	Start  string `json:"start"`
+	End    string `json:"end"`
+	Stats  *Stats `json:"stats"`
+}
+
+type PresentationConnection struct {
+	Edges      []*PresentationEdge `json:"edges"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+type PresentationEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *Presentation `json:"node"`
+}
+
+type PresentationFilter struct {
+	Keyword *string `json:"keyword"`
+	Speaker *string `json:"speaker"`
+}
+
+type PresentationOrder struct {
+	Field     PresentationOrderField `json:"field"`
+	Direction *OrderDirection        `json:"direction"`
+}
+
+type SpeakerConnection struct {
+	Edges      []*SpeakerEdge `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+type SpeakerEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Speaker `json:"node"`
+}
+
+type SpeakerFilter struct {
+	Company *string `json:"company"`
+	Country *string `json:"country"`
+}
+
+type SpeakerOrder struct {
+	Field     SpeakerOrderField `json:"field"`
+	Direction *OrderDirection   `json:"direction"`
+}
+
+type SponsorTierCount struct {
+	Tier  string `json:"tier"`
+	Count int    `json:"count"`
+}
+
+type CompanyOrderField string
+
+const (
+	CompanyOrderFieldID   CompanyOrderField = "ID"
+	CompanyOrderFieldName CompanyOrderField = "NAME"
+)
+
+var AllCompanyOrderField = []CompanyOrderField{
+	CompanyOrderFieldID,
+	CompanyOrderFieldName,
+}
+
+func (e CompanyOrderField) IsValid() bool {
+	switch e {
+	case CompanyOrderFieldID, CompanyOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e CompanyOrderField) String() string {
+	return string(e)
+}
+
+func (e *CompanyOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CompanyOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CompanyOrderField", str)
+	}
+	return nil
+}
+
+func (e CompanyOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MeetupOrderField string
+
+const (
+	MeetupOrderFieldDate      MeetupOrderField = "DATE"
+	MeetupOrderFieldName      MeetupOrderField = "NAME"
+	MeetupOrderFieldAttendees MeetupOrderField = "ATTENDEES"
+)
+
+var AllMeetupOrderField = []MeetupOrderField{
+	MeetupOrderFieldDate,
+	MeetupOrderFieldName,
+	MeetupOrderFieldAttendees,
+}
+
+func (e MeetupOrderField) IsValid() bool {
+	switch e {
+	case MeetupOrderFieldDate, MeetupOrderFieldName, MeetupOrderFieldAttendees:
+		return true
+	}
+	return false
+}
+
+func (e MeetupOrderField) String() string {
+	return string(e)
+}
+
+func (e *MeetupOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MeetupOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MeetupOrderField", str)
+	}
+	return nil
+}
+
+func (e MeetupOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PresentationOrderField string
+
+const (
+	PresentationOrderFieldDate  PresentationOrderField = "DATE"
+	PresentationOrderFieldTitle PresentationOrderField = "TITLE"
+)
+
+var AllPresentationOrderField = []PresentationOrderField{
+	PresentationOrderFieldDate,
+	PresentationOrderFieldTitle,
+}
+
+func (e PresentationOrderField) IsValid() bool {
+	switch e {
+	case PresentationOrderFieldDate, PresentationOrderFieldTitle:
+		return true
+	}
+	return false
+}
+
+func (e PresentationOrderField) String() string {
+	return string(e)
+}
+
+func (e *PresentationOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PresentationOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PresentationOrderField", str)
+	}
+	return nil
+}
+
+func (e PresentationOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SearchType string
+
+const (
+	SearchTypePresentation SearchType = "PRESENTATION"
+	SearchTypeMeetup       SearchType = "MEETUP"
+	SearchTypeMeetupGroup  SearchType = "MEETUP_GROUP"
+	SearchTypeSpeaker      SearchType = "SPEAKER"
+	SearchTypeCompany      SearchType = "COMPANY"
+)
+
+var AllSearchType = []SearchType{
+	SearchTypePresentation,
+	SearchTypeMeetup,
+	SearchTypeMeetupGroup,
+	SearchTypeSpeaker,
+	SearchTypeCompany,
+}
+
+func (e SearchType) IsValid() bool {
+	switch e {
+	case SearchTypePresentation, SearchTypeMeetup, SearchTypeMeetupGroup, SearchTypeSpeaker, SearchTypeCompany:
+		return true
+	}
+	return false
+}
+
+func (e SearchType) String() string {
+	return string(e)
+}
+
+func (e *SearchType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchType", str)
+	}
+	return nil
+}
+
+func (e SearchType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SpeakerOrderField string
+
+const (
+	SpeakerOrderFieldID   SpeakerOrderField = "ID"
+	SpeakerOrderFieldName SpeakerOrderField = "NAME"
+)
+
+var AllSpeakerOrderField = []SpeakerOrderField{
+	SpeakerOrderFieldID,
+	SpeakerOrderFieldName,
+}
+
+func (e SpeakerOrderField) IsValid() bool {
+	switch e {
+	case SpeakerOrderFieldID, SpeakerOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e SpeakerOrderField) String() string {
+	return string(e)
+}
+
+func (e *SpeakerOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SpeakerOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SpeakerOrderField", str)
+	}
+	return nil
+}
+
+func (e SpeakerOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type StatsPeriod string
+
+const (
+	StatsPeriodYear    StatsPeriod = "YEAR"
+	StatsPeriodQuarter StatsPeriod = "QUARTER"
+)
+
+var AllStatsPeriod = []StatsPeriod{
+	StatsPeriodYear,
+	StatsPeriodQuarter,
+}
+
+func (e StatsPeriod) IsValid() bool {
+	switch e {
+	case StatsPeriodYear, StatsPeriodQuarter:
+		return true
+	}
+	return false
+}
+
+func (e StatsPeriod) String() string {
+	return string(e)
+}
+
+func (e *StatsPeriod) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatsPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatsPeriod", str)
+	}
+	return nil
+}
+
+func (e StatsPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
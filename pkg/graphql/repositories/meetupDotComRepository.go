@@ -1,11 +1,11 @@
 package repositories
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/models"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/httpclient"
 )
 
 // GetMeetupInfoFromAPI fetches all information it can about the given meetup group
@@ -37,13 +37,5 @@ type meetupGroupAPI struct {
 }
 
 func getJSON(url string, v interface{}) error {
-	resp, err := http.Get(url)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-		return fmt.Errorf("GetJSON failed for url %s with error %v", url, err)
-	}
-	return nil
+	return httpclient.Default.GetJSON(context.Background(), url, v)
 }
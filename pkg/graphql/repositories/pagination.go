@@ -0,0 +1,268 @@
+package repositories
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/models"
+	"github.com/hashicorp/go-memdb"
+)
+
+//Match selects the nodes to list, a nil Match selects all nodes
+type Match func(obj interface{}) bool
+
+func (m Match) matches(obj interface{}) bool {
+	return m == nil || m(obj)
+}
+
+//SortKey returns the key of a node in a sort index from the values to sort by, the first value
+//sorts first. The values are terminated by NUL bytes, for the keys to sort like the values.
+func SortKey(values ...string) string {
+	b := strings.Builder{}
+	for _, v := range values {
+		b.WriteString(strings.Replace(v, "\x00", "", -1))
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+//SortIndex indexes the nodes of a table by the unique keys returned by Key, see SortKey.
+//If Desc is true, the keys are inverted to list the nodes in the reverse order.
+type SortIndex struct {
+	Key  func(obj interface{}) string
+	Desc bool
+}
+
+var _ memdb.SingleIndexer = &SortIndex{}
+
+func (s *SortIndex) FromObject(obj interface{}) (bool, []byte, error) {
+	return true, orderedKey(s.Key(obj), s.Desc), nil
+}
+
+//FromArgs takes a key in the order of the index, e.g. the bound to start listing from
+func (s *SortIndex) FromArgs(args ...interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("must provide only a single argument")
+	}
+	key, ok := args[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("argument must be a []byte: %#v", args[0])
+	}
+	return key, nil
+}
+
+//orderedKey inverts the bytes of the key if desc is true. As the keys are terminated by NUL bytes,
+//none of them is a prefix of another one, so the inverted keys sort in the reverse order.
+func orderedKey(key string, desc bool) []byte {
+	b := []byte(key)
+	if desc {
+		for i := range b {
+			b[i] = ^b[i]
+		}
+	}
+	return b
+}
+
+//Listing lists the nodes of a table in the order of a sort index
+type Listing struct {
+	Table string
+	//Index is the name of the sort index in ascending order, Index+"_desc" is the same index in descending order
+	Index string
+	Desc  bool
+	//Key returns the key of a node in the sort index
+	Key func(obj interface{}) string
+	//IDs are the IDs of the nodes to list, found in other indexes. If IDs is nil, the sort index is walked instead.
+	IDs   []interface{}
+	Match Match
+}
+
+//iterator returns the nodes one by one, and nil at the end
+type iterator func() interface{}
+
+//seek returns an iterator over the nodes of the listing from the key on, in the order of the listing or in the
+//reverse order. The nodes with the key itself are skipped unless inclusive is true.
+type seek func(key *string, reverse, inclusive bool) (iterator, error)
+
+//seekIndex seeks to the key in the sort index
+func (l *Listing) seekIndex(txn *memdb.Txn) seek {
+	return func(key *string, reverse, inclusive bool) (iterator, error) {
+		desc := l.Desc != reverse
+		index := l.Index
+		if desc {
+			index += "_desc"
+		}
+		// The radix tree can't seek to an empty key, list the whole index instead
+		var it memdb.ResultIterator
+		var err error
+		if key != nil {
+			it, err = txn.LowerBound(l.Table, index, orderedKey(*key, desc))
+		} else {
+			it, err = txn.Get(l.Table, index)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return func() interface{} {
+			for obj := it.Next(); obj != nil; obj = it.Next() {
+				if key != nil && !inclusive && l.Key(obj) == *key {
+					continue
+				}
+				if l.Match.matches(obj) {
+					return obj
+				}
+			}
+			return nil
+		}, nil
+	}
+}
+
+//seekSorted seeks to the key in the nodes, sorted in the order of the listing
+func (l *Listing) seekSorted(nodes []interface{}) seek {
+	keys := make([][]byte, len(nodes))
+	for i, obj := range nodes {
+		keys[i] = orderedKey(l.Key(obj), l.Desc)
+	}
+	sort.Sort(byKeys{nodes, keys})
+	return func(key *string, reverse, inclusive bool) (iterator, error) {
+		var from []byte
+		if key != nil {
+			from = orderedKey(*key, l.Desc)
+		}
+		i, step := 0, 1
+		if reverse {
+			// The last node before or at the key
+			i, step = len(keys)-1, -1
+			if from != nil {
+				i = sort.Search(len(keys), func(i int) bool { return bytes.Compare(keys[i], from) > 0 }) - 1
+			}
+		} else if from != nil {
+			i = sort.Search(len(keys), func(i int) bool { return bytes.Compare(keys[i], from) >= 0 })
+		}
+		return func() interface{} {
+			for ; i >= 0 && i < len(nodes); i += step {
+				if from != nil && !inclusive && bytes.Equal(keys[i], from) {
+					continue
+				}
+				obj := nodes[i]
+				i += step
+				return obj
+			}
+			return nil
+		}, nil
+	}
+}
+
+type byKeys struct {
+	nodes []interface{}
+	keys  [][]byte
+}
+
+func (b byKeys) Len() int           { return len(b.nodes) }
+func (b byKeys) Less(i, j int) bool { return bytes.Compare(b.keys[i], b.keys[j]) < 0 }
+func (b byKeys) Swap(i, j int) {
+	b.nodes[i], b.nodes[j] = b.nodes[j], b.nodes[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
+
+//Paginate returns the page of the listing for the pagination arguments, see
+//https://relay.dev/graphql/connections.htm#sec-Pagination-algorithm, and the number of nodes in the listing.
+//after and before are keys of the sort index, the page is found by seeking to them,
+//so the keys stay valid even if the nodes are gone. The cursors of the PageInfo are left to the caller.
+func (sr *StatsRepository) Paginate(ctx context.Context, l *Listing, first *int, after *string, last *int, before *string) ([]interface{}, *models.PageInfo, int, error) {
+	if first != nil && *first < 0 {
+		return nil, nil, 0, fmt.Errorf("first must not be negative")
+	}
+	if last != nil && *last < 0 {
+		return nil, nil, 0, fmt.Errorf("last must not be negative")
+	}
+	// Create read-only transaction
+	txn := sr.txn(ctx)
+	defer txn.Abort()
+
+	total := 0
+	var seek seek
+	if l.IDs != nil {
+		nodes := []interface{}{}
+		for _, id := range l.IDs {
+			obj, err := txn.First(l.Table, "id", id)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			if obj != nil && l.Match.matches(obj) {
+				nodes = append(nodes, obj)
+			}
+		}
+		total = len(nodes)
+		seek = l.seekSorted(nodes)
+	} else {
+		it, err := txn.Get(l.Table, "id")
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			if l.Match.matches(obj) {
+				total++
+			}
+		}
+		seek = l.seekIndex(txn)
+	}
+
+	// Without first, but with last, the page is taken from the end, walking the other way round
+	reverse := first == nil && last != nil
+	from, to, limit := after, before, first
+	if reverse {
+		from, to, limit = before, after, last
+	}
+	var toKey []byte
+	if to != nil {
+		toKey = orderedKey(*to, l.Desc != reverse)
+	}
+
+	nodes := []interface{}{}
+	more := false
+	next, err := seek(from, reverse, false)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	for obj := next(); obj != nil; obj = next() {
+		// The nodes past the end of the page only tell whether there are more
+		if (toKey != nil && bytes.Compare(orderedKey(l.Key(obj), l.Desc != reverse), toKey) >= 0) || (limit != nil && len(nodes) == *limit) {
+			more = true
+			break
+		}
+		nodes = append(nodes, obj)
+	}
+	if !more && to != nil {
+		// The nodes from the other cursor on follow the page, even if the page ends before it, e.g. if the cursors are crossed
+		next, err := seek(to, reverse, true)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		more = next() != nil
+	}
+
+	// The nodes up to the start of the page
+	previous := false
+	if from != nil {
+		next, err := seek(from, !reverse, true)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		previous = next() != nil
+	}
+
+	pageInfo := &models.PageInfo{HasPreviousPage: previous, HasNextPage: more}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+		pageInfo.HasPreviousPage, pageInfo.HasNextPage = more, previous
+	} else if last != nil && len(nodes) > *last {
+		nodes = nodes[len(nodes)-*last:]
+		pageInfo.HasPreviousPage = true
+	}
+	return nodes, pageInfo, total, nil
+}
@@ -1,27 +1,57 @@
 package repositories
 
 import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/models"
+	"github.com/cloud-native-nordics/meetup-kit/pkg/graphql/search"
 	"github.com/hashicorp/go-memdb"
 )
 
+//DBProvider provides the current in-mem database
+type DBProvider interface {
+	DB() *memdb.MemDB
+}
+
 //StatsRepository is used to query the in-mem database
 type StatsRepository struct {
-	db *memdb.MemDB
+	provider DBProvider
+}
+
+type dbKey struct{}
+
+//WithDB returns a copy of ctx that makes the StatsRepository query db. All queries
+//of a request should use the same database, for consistent results when the data is reloaded.
+func WithDB(ctx context.Context, db *memdb.MemDB) context.Context {
+	return context.WithValue(ctx, dbKey{}, db)
 }
 
-//NewStatsRepository returns a new Stats Repository to be used for fetching data from the in-mem database
-func NewStatsRepository(db *memdb.MemDB) *StatsRepository {
+//NewStatsRepository returns a new Stats Repository to be used for fetching data from the in-mem database.
+//The database is taken from the context, see WithDB, or else from provider.
+func NewStatsRepository(provider DBProvider) *StatsRepository {
 	return &StatsRepository{
-		db: db,
+		provider: provider,
 	}
 }
 
+//txn creates a read-only transaction on the database of the request
+func (sr *StatsRepository) txn(ctx context.Context) *memdb.Txn {
+	if db, ok := ctx.Value(dbKey{}).(*memdb.MemDB); ok && db != nil {
+		return db.Txn(false)
+	}
+	return sr.provider.DB().Txn(false)
+}
+
 // ### Meetup Groups ###
-func (sr *StatsRepository) GetAllMeetupGroups() ([]*models.MeetupGroup, error) {
+func (sr *StatsRepository) GetAllMeetupGroups(ctx context.Context) ([]*models.MeetupGroup, error) {
 	output := []*models.MeetupGroup{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	// List all meetup groups
@@ -38,9 +68,9 @@ func (sr *StatsRepository) GetAllMeetupGroups() ([]*models.MeetupGroup, error) {
 	return output, nil
 }
 
-func (sr *StatsRepository) GetMeetupGroup(id string) (*models.MeetupGroup, error) {
+func (sr *StatsRepository) GetMeetupGroup(ctx context.Context, id string) (*models.MeetupGroup, error) {
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	//Get meetup group by id
@@ -53,9 +83,9 @@ func (sr *StatsRepository) GetMeetupGroup(id string) (*models.MeetupGroup, error
 	return &out, nil
 }
 
-func (sr *StatsRepository) GetMeetupGroupForMeetup(id int) (*models.MeetupGroup, error) {
+func (sr *StatsRepository) GetMeetupGroupForMeetup(ctx context.Context, id int) (*models.MeetupGroup, error) {
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	//Get meetup group by id
@@ -74,10 +104,10 @@ func (sr *StatsRepository) GetMeetupGroupForMeetup(id int) (*models.MeetupGroup,
 	return &out, nil
 }
 
-func (sr *StatsRepository) GetSponsorTiersForMeetupGroup(id string) ([]*models.SponsorTier, error) {
+func (sr *StatsRepository) GetSponsorTiersForMeetupGroup(ctx context.Context, id string) ([]*models.SponsorTier, error) {
 	output := []*models.SponsorTier{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	// List all sponsor tier relations for meetup group
@@ -99,10 +129,10 @@ func (sr *StatsRepository) GetSponsorTiersForMeetupGroup(id string) ([]*models.S
 	return output, nil
 }
 
-func (sr *StatsRepository) GetMeetupGroupsForSponsorTier(id string) ([]*models.MeetupGroup, error) {
+func (sr *StatsRepository) GetMeetupGroupsForSponsorTier(ctx context.Context, id string) ([]*models.MeetupGroup, error) {
 	output := []*models.MeetupGroup{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	// List all sponsor tier relations for meetup group
@@ -125,10 +155,10 @@ func (sr *StatsRepository) GetMeetupGroupsForSponsorTier(id string) ([]*models.M
 	return output, nil
 }
 
-func (sr *StatsRepository) GetOrganizersForMeetupGroup(id string) ([]*models.Speaker, error) {
+func (sr *StatsRepository) GetOrganizersForMeetupGroup(ctx context.Context, id string) ([]*models.Speaker, error) {
 	output := []*models.Speaker{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	relations, err := txn.Get("meetupGroupToOrganizer", "meetupGroupID", id)
@@ -149,10 +179,10 @@ func (sr *StatsRepository) GetOrganizersForMeetupGroup(id string) ([]*models.Spe
 	return output, nil
 }
 
-func (sr *StatsRepository) GetEcosystemMembersForMeetupGroup(id string) ([]*models.Company, error) {
+func (sr *StatsRepository) GetEcosystemMembersForMeetupGroup(ctx context.Context, id string) ([]*models.Company, error) {
 	output := []*models.Company{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	relations, err := txn.Get("meetupGroupToEcosystemMember", "meetupGroupID", id)
@@ -173,10 +203,10 @@ func (sr *StatsRepository) GetEcosystemMembersForMeetupGroup(id string) ([]*mode
 	return output, nil
 }
 
-func (sr *StatsRepository) GetMeetupsForMeetupGroup(id string) ([]*models.Meetup, error) {
+func (sr *StatsRepository) GetMeetupsForMeetupGroup(ctx context.Context, id string) ([]*models.Meetup, error) {
 	output := []*models.Meetup{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	relations, err := txn.Get("meetupGroupToMeetup", "meetupGroupID", id)
@@ -198,9 +228,9 @@ func (sr *StatsRepository) GetMeetupsForMeetupGroup(id string) ([]*models.Meetup
 }
 
 // ### Sponsor Tier ###
-func (sr *StatsRepository) GetCompanyForSponsorTier(id string) (*models.Company, error) {
+func (sr *StatsRepository) GetCompanyForSponsorTier(ctx context.Context, id string) (*models.Company, error) {
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	relations, err := txn.First("sponsorTierToCompany", "sponsorTierID", id)
@@ -219,10 +249,10 @@ func (sr *StatsRepository) GetCompanyForSponsorTier(id string) (*models.Company,
 }
 
 // ### Companies ###
-func (sr *StatsRepository) GetAllCompanies() ([]*models.Company, error) {
+func (sr *StatsRepository) GetAllCompanies(ctx context.Context) ([]*models.Company, error) {
 	output := []*models.Company{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	// List all companies
@@ -239,9 +269,9 @@ func (sr *StatsRepository) GetAllCompanies() ([]*models.Company, error) {
 	return output, nil
 }
 
-func (sr *StatsRepository) GetCompany(id string) (*models.Company, error) {
+func (sr *StatsRepository) GetCompany(ctx context.Context, id string) (*models.Company, error) {
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	//Get company by id
@@ -254,10 +284,10 @@ func (sr *StatsRepository) GetCompany(id string) (*models.Company, error) {
 	return &out, nil
 }
 
-func (sr *StatsRepository) GetCountriesForCompany(id string) ([]*string, error) {
+func (sr *StatsRepository) GetCountriesForCompany(ctx context.Context, id string) ([]*string, error) {
 	var output []*string
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	sponsorTierRelations, err := txn.Get("sponsorTierToCompany", "companyID", id)
@@ -291,10 +321,10 @@ func (sr *StatsRepository) GetCountriesForCompany(id string) ([]*string, error)
 	return output, nil
 }
 
-func (sr *StatsRepository) GetSponsorTiersForCompany(id string) ([]*models.SponsorTier, error) {
+func (sr *StatsRepository) GetSponsorTiersForCompany(ctx context.Context, id string) ([]*models.SponsorTier, error) {
 	output := []*models.SponsorTier{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	sponsorTierRelations, err := txn.Get("sponsorTierToCompany", "companyID", id)
@@ -317,10 +347,10 @@ func (sr *StatsRepository) GetSponsorTiersForCompany(id string) ([]*models.Spons
 	return output, nil
 }
 
-func (sr *StatsRepository) GetSpeakersForCompany(id string) ([]*models.Speaker, error) {
+func (sr *StatsRepository) GetSpeakersForCompany(ctx context.Context, id string) ([]*models.Speaker, error) {
 	output := []*models.Speaker{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	speakerRelations, err := txn.Get("speakerToCompany", "companyID", id)
@@ -344,10 +374,10 @@ func (sr *StatsRepository) GetSpeakersForCompany(id string) ([]*models.Speaker,
 }
 
 // ### Meetups ###
-func (sr *StatsRepository) GetAllMeetups() ([]*models.Meetup, error) {
+func (sr *StatsRepository) GetAllMeetups(ctx context.Context) ([]*models.Meetup, error) {
 	output := []*models.Meetup{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	// List all meetups
@@ -364,9 +394,59 @@ func (sr *StatsRepository) GetAllMeetups() ([]*models.Meetup, error) {
 	return output, nil
 }
 
-func (sr *StatsRepository) GetMeetup(id int) (*models.Meetup, error) {
+//ListMeetups returns the listing of the meetups matching the filter. The filters use the indexes
+//to narrow down the meetups, instead of scanning all of them.
+func (sr *StatsRepository) ListMeetups(ctx context.Context, filter *models.MeetupFilter) (*Listing, error) {
+	if filter == nil {
+		filter = &models.MeetupFilter{}
+	}
+	from, to, err := dateRange(filter.From, filter.To)
+	if err != nil {
+		return nil, err
+	}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
+	defer txn.Abort()
+
+	// A nil set means that all meetups match
+	var meetupIDs map[int]bool
+	if filter.Country != nil || filter.City != nil || filter.MeetupGroup != nil {
+		meetupGroupIDs, err := findMeetupGroups(txn, filter.Country, filter.City, filter.MeetupGroup)
+		if err != nil {
+			return nil, err
+		}
+		meetupIDs, err = meetupsOfMeetupGroups(txn, meetupGroupIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if filter.Sponsor != nil {
+		sponsored, err := meetupsSponsoredBy(txn, *filter.Sponsor)
+		if err != nil {
+			return nil, err
+		}
+		meetupIDs = intersectInts(meetupIDs, sponsored)
+	}
+
+	listing := &Listing{Table: "meetup"}
+	if meetupIDs != nil {
+		listing.IDs = []interface{}{}
+		for id := range meetupIDs {
+			listing.IDs = append(listing.IDs, id)
+		}
+	}
+	if len(from) != 0 || len(to) != 0 {
+		listing.Match = func(obj interface{}) bool {
+			date := obj.(models.Meetup).Date
+			return date >= from && (len(to) == 0 || date < to)
+		}
+	}
+	return listing, nil
+}
+
+func (sr *StatsRepository) GetMeetup(ctx context.Context, id int) (*models.Meetup, error) {
+	// Create read-only transaction
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	//Get meetup by id
@@ -379,10 +459,10 @@ func (sr *StatsRepository) GetMeetup(id int) (*models.Meetup, error) {
 	return &out, nil
 }
 
-func (sr *StatsRepository) GetSponsorsForMeetup(id int) ([]*models.Sponsor, error) {
+func (sr *StatsRepository) GetSponsorsForMeetup(ctx context.Context, id int) ([]*models.Sponsor, error) {
 	output := []*models.Sponsor{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	relations, err := txn.Get("meetupToSponsor", "meetupID", id)
@@ -403,24 +483,19 @@ func (sr *StatsRepository) GetSponsorsForMeetup(id int) ([]*models.Sponsor, erro
 	return output, nil
 }
 
-func (sr *StatsRepository) GetPresentationsForMeetup(id int) ([]*models.Presentation, error) {
+func (sr *StatsRepository) GetPresentationsForMeetup(ctx context.Context, id int) ([]*models.Presentation, error) {
 	output := []*models.Presentation{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
-	relations, err := txn.Get("meetupToPresentation", "meetupID", id)
+	it, err := txn.Get("presentation", "meetupID", id)
 	if err != nil {
 		return nil, err
 	}
 
-	for obj := relations.Next(); obj != nil; obj = relations.Next() {
-		relation := obj.(models.MeetupToPresentation)
-		it, err := txn.First("presentation", "id", relation.PresentationID)
-		if err != nil {
-			return nil, err
-		}
-		result := it.(models.Presentation)
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		result := obj.(models.Presentation)
 		output = append(output, &result)
 	}
 
@@ -428,10 +503,10 @@ func (sr *StatsRepository) GetPresentationsForMeetup(id int) ([]*models.Presenta
 }
 
 /// ### Presentations ###
-func (sr *StatsRepository) GetAllPresentations() ([]*models.Presentation, error) {
+func (sr *StatsRepository) GetAllPresentations(ctx context.Context) ([]*models.Presentation, error) {
 	output := []*models.Presentation{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	// List all presentations
@@ -448,9 +523,48 @@ func (sr *StatsRepository) GetAllPresentations() ([]*models.Presentation, error)
 	return output, nil
 }
 
-func (sr *StatsRepository) GetPresentation(id string) (*models.Presentation, error) {
+//ListPresentations returns the listing of the presentations matching the filter
+func (sr *StatsRepository) ListPresentations(ctx context.Context, filter *models.PresentationFilter) (*Listing, error) {
+	if filter == nil {
+		filter = &models.PresentationFilter{}
+	}
+	// Create read-only transaction
+	txn := sr.txn(ctx)
+	defer txn.Abort()
+
+	// A nil set means that all presentations match
+	var presentationIDs map[string]bool
+	if filter.Speaker != nil {
+		presentationIDs = map[string]bool{}
+		relations, err := txn.Get("presentationToSpeaker", "speakerID", *filter.Speaker)
+		if err != nil {
+			return nil, err
+		}
+		for obj := relations.Next(); obj != nil; obj = relations.Next() {
+			presentationIDs[obj.(models.PresentationToSpeaker).PresentationID] = true
+		}
+	}
+
+	listing := &Listing{Table: "presentation"}
+	if filter.Keyword != nil {
+		keyword := strings.ToLower(*filter.Keyword)
+		titled, err := presentationsTitled(txn, keyword)
+		if err != nil {
+			return nil, err
+		}
+		presentationIDs = intersectStrings(presentationIDs, titled)
+		// The index matches words starting with the words of the keyword, the title must contain it as a whole
+		listing.Match = func(obj interface{}) bool {
+			return strings.Contains(strings.ToLower(obj.(models.Presentation).Title), keyword)
+		}
+	}
+	listing.IDs = stringIDs(presentationIDs)
+	return listing, nil
+}
+
+func (sr *StatsRepository) GetPresentation(ctx context.Context, id string) (*models.Presentation, error) {
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	//Get presentation by id
@@ -463,10 +577,10 @@ func (sr *StatsRepository) GetPresentation(id string) (*models.Presentation, err
 	return &out, nil
 }
 
-func (sr *StatsRepository) GetSpeakersForPresentation(id string) ([]*models.Speaker, error) {
+func (sr *StatsRepository) GetSpeakersForPresentation(ctx context.Context, id string) ([]*models.Speaker, error) {
 	output := []*models.Speaker{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	relations, err := txn.Get("presentationToSpeaker", "presentationID", id)
@@ -487,18 +601,17 @@ func (sr *StatsRepository) GetSpeakersForPresentation(id string) ([]*models.Spea
 	return output, nil
 }
 
-func (sr *StatsRepository) GetMeetupForPresentation(id string) (*models.Meetup, error) {
+func (sr *StatsRepository) GetMeetupForPresentation(ctx context.Context, id string) (*models.Meetup, error) {
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
-	relations, err := txn.First("meetupToPresentation", "presentationID", id)
+	presentation, err := txn.First("presentation", "id", id)
 	if err != nil {
 		return nil, err
 	}
 
-	relation := relations.(models.MeetupToPresentation)
-	it, err := txn.First("meetup", "id", relation.MeetupID)
+	it, err := txn.First("meetup", "id", presentation.(models.Presentation).MeetupID)
 	if err != nil {
 		return nil, err
 	}
@@ -508,10 +621,10 @@ func (sr *StatsRepository) GetMeetupForPresentation(id string) (*models.Meetup,
 }
 
 // ### Speakers ###
-func (sr *StatsRepository) GetAllSpeakers() ([]*models.Speaker, error) {
+func (sr *StatsRepository) GetAllSpeakers(ctx context.Context) ([]*models.Speaker, error) {
 	output := []*models.Speaker{}
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	// List all speakers
@@ -528,9 +641,41 @@ func (sr *StatsRepository) GetAllSpeakers() ([]*models.Speaker, error) {
 	return output, nil
 }
 
-func (sr *StatsRepository) GetSpeaker(id string) (*models.Speaker, error) {
+//ListSpeakers returns the listing of the speakers matching the filter
+func (sr *StatsRepository) ListSpeakers(ctx context.Context, filter *models.SpeakerFilter) (*Listing, error) {
+	if filter == nil {
+		filter = &models.SpeakerFilter{}
+	}
+	// Create read-only transaction
+	txn := sr.txn(ctx)
+	defer txn.Abort()
+
+	// A nil set means that all speakers match
+	var speakerIDs map[string]bool
+	if filter.Company != nil {
+		speakerIDs = map[string]bool{}
+		relations, err := txn.Get("speakerToCompany", "companyID", *filter.Company)
+		if err != nil {
+			return nil, err
+		}
+		for obj := relations.Next(); obj != nil; obj = relations.Next() {
+			speakerIDs[obj.(models.SpeakerToCompany).SpeakerID] = true
+		}
+	}
+	if filter.Country != nil {
+		presenting, err := speakersPresentingIn(txn, *filter.Country)
+		if err != nil {
+			return nil, err
+		}
+		speakerIDs = intersectStrings(speakerIDs, presenting)
+	}
+
+	return &Listing{Table: "speaker", IDs: stringIDs(speakerIDs)}, nil
+}
+
+func (sr *StatsRepository) GetSpeaker(ctx context.Context, id string) (*models.Speaker, error) {
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	//Get speaker by id
@@ -543,9 +688,9 @@ func (sr *StatsRepository) GetSpeaker(id string) (*models.Speaker, error) {
 	return &out, nil
 }
 
-func (sr *StatsRepository) GetCompanyForSpeaker(id string) (*models.Company, error) {
+func (sr *StatsRepository) GetCompanyForSpeaker(ctx context.Context, id string) (*models.Company, error) {
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	relations, err := txn.First("speakerToCompany", "speakerID", id)
@@ -566,10 +711,10 @@ func (sr *StatsRepository) GetCompanyForSpeaker(id string) (*models.Company, err
 	return nil, nil
 }
 
-func (sr *StatsRepository) GetCountriesForSpeaker(id string) ([]*string, error) {
+func (sr *StatsRepository) GetCountriesForSpeaker(ctx context.Context, id string) ([]*string, error) {
 	var output []*string
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	presentationRelations, err := txn.Get("presentationToSpeaker", "speakerID", id)
@@ -611,10 +756,10 @@ func (sr *StatsRepository) GetCountriesForSpeaker(id string) ([]*string, error)
 	return output, nil
 }
 
-func (sr *StatsRepository) GetPresentationsForSpeaker(id string) ([]*models.Presentation, error) {
+func (sr *StatsRepository) GetPresentationsForSpeaker(ctx context.Context, id string) ([]*models.Presentation, error) {
 	var output []*models.Presentation
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	presentationRelations, err := txn.Get("presentationToSpeaker", "speakerID", id)
@@ -638,9 +783,9 @@ func (sr *StatsRepository) GetPresentationsForSpeaker(id string) ([]*models.Pres
 }
 
 // ### Sponsor ###
-func (sr *StatsRepository) GetCompanyForSponsor(id string) (*models.Company, error) {
+func (sr *StatsRepository) GetCompanyForSponsor(ctx context.Context, id string) (*models.Company, error) {
 	// Create read-only transaction
-	txn := sr.db.Txn(false)
+	txn := sr.txn(ctx)
 	defer txn.Abort()
 
 	relations, err := txn.First("sponsorToCompany", "sponsorID", id)
@@ -661,7 +806,339 @@ func (sr *StatsRepository) GetCompanyForSponsor(id string) (*models.Company, err
 	return nil, nil
 }
 
+// ### Stats ###
+
+//GetStats returns the statistics for all time of the meetup group, or of all of them for models.StatsScopeAll
+func (sr *StatsRepository) GetStats(ctx context.Context, scope string) (*models.Stats, error) {
+	// Create read-only transaction
+	txn := sr.txn(ctx)
+	defer txn.Abort()
+
+	it, err := txn.First("stats", "id", scope+":")
+	if err != nil {
+		return nil, err
+	}
+	if it == nil {
+		return nil, fmt.Errorf("no statistics for %s", scope)
+	}
+
+	out := it.(models.Stats)
+	return &out, nil
+}
+
+//GetStatsByPeriod returns the statistics per period of the meetup group, or of all of them for models.StatsScopeAll
+func (sr *StatsRepository) GetStatsByPeriod(ctx context.Context, scope string, granularity string) ([]*models.Stats, error) {
+	output := []*models.Stats{}
+	// Create read-only transaction
+	txn := sr.txn(ctx)
+	defer txn.Abort()
+
+	// The IDs end with the period, so the statistics are listed chronologically
+	it, err := txn.Get("stats", "granularity", scope, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		p := obj.(models.Stats)
+		output = append(output, &p)
+	}
+
+	return output, nil
+}
+
+// ### Search ###
+
+//snippetSize is the length of the snippets of the search results, in characters
+const snippetSize = 160
+
+//Search returns the nodes of the given types matching all words of the query, the best matches first.
+//The words match the terms starting with them, exact matches rank higher. The ranking is TF-IDF,
+//weighted by the field, e.g. a match in a name ranks higher than one in a description.
+func (sr *StatsRepository) Search(ctx context.Context, query string, types []models.SearchType) ([]*models.SearchResult, error) {
+	output := []*models.SearchResult{}
+	terms := search.Tokenize(query)
+	if len(terms) == 0 {
+		return output, nil
+	}
+	wanted := map[string]bool{}
+	for _, t := range types {
+		wanted[string(t)] = true
+	}
+	// Create read-only transaction
+	txn := sr.txn(ctx)
+	defer txn.Abort()
+
+	obj, err := txn.First("searchIndex", "id", models.SearchIndexID)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return output, nil
+	}
+	total := obj.(models.SearchIndex).Documents
+
+	type hit struct {
+		result  *models.SearchResult
+		matched map[int]bool
+		best    float64
+		text    string
+	}
+	hits := map[string]*hit{}
+	for i, term := range terms {
+		// Sum the occurrences of the terms starting with the word per document
+		counts := map[string]float64{}
+		postings, err := txn.Get("searchTerm", "term_prefix", term)
+		if err != nil {
+			return nil, err
+		}
+		for obj := postings.Next(); obj != nil; obj = postings.Next() {
+			posting := obj.(models.SearchTerm)
+			count := float64(posting.Count)
+			if posting.Term != term {
+				count /= 2
+			}
+			counts[posting.DocumentID] += count
+		}
+		idf := math.Log(1 + float64(total)/float64(len(counts)+1))
+
+		for documentID, count := range counts {
+			obj, err := txn.First("searchDocument", "id", documentID)
+			if err != nil {
+				return nil, err
+			}
+			document := obj.(models.SearchDocument)
+			if len(wanted) != 0 && !wanted[document.Type] {
+				continue
+			}
+			key := document.Type + ":" + document.NodeID
+			h, ok := hits[key]
+			if !ok {
+				h = &hit{
+					result:  &models.SearchResult{Type: models.SearchType(document.Type), ID: document.NodeID},
+					matched: map[int]bool{},
+				}
+				hits[key] = h
+			}
+			score := document.Weight * (1 + math.Log(count)) * idf
+			h.result.Score += score
+			h.matched[i] = true
+			if score > h.best {
+				h.best = score
+				h.result.Field = document.Field
+				h.text = document.Text
+			}
+		}
+	}
+
+	for _, h := range hits {
+		if len(h.matched) != len(terms) {
+			continue
+		}
+		h.result.Snippet = search.Snippet(h.text, terms, snippetSize)
+		output = append(output, h.result)
+	}
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].Score != output[j].Score {
+			return output[i].Score > output[j].Score
+		}
+		if output[i].Type != output[j].Type {
+			return output[i].Type < output[j].Type
+		}
+		return output[i].ID < output[j].ID
+	})
+
+	return output, nil
+}
+
+// ### Filters ###
+
+//dateRange returns the range of the meetup dates to list, the end is exclusive. Dates
+//like 2019-01-01 include the whole day, times must be formatted like 2019-01-01T18:00:00Z.
+func dateRange(from, to *string) (string, string, error) {
+	start, end := "", ""
+	if from != nil {
+		t, err := parseDate(*from, false)
+		if err != nil {
+			return "", "", err
+		}
+		start = t.UTC().Format(time.RFC3339)
+	}
+	if to != nil {
+		t, err := parseDate(*to, true)
+		if err != nil {
+			return "", "", err
+		}
+		end = t.UTC().Format(time.RFC3339)
+	}
+	return start, end, nil
+}
+
+func parseDate(s string, end bool) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		if end {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, use e.g. 2019-01-01 or 2019-01-01T18:00:00Z", s)
+	}
+	if end {
+		t = t.Add(time.Second)
+	}
+	return t, nil
+}
+
+//findMeetupGroups returns the IDs of the meetup groups in the country and city, and with the ID, if set
+func findMeetupGroups(txn *memdb.Txn, country, city, meetupGroupID *string) (map[string]bool, error) {
+	var output map[string]bool
+	if meetupGroupID != nil {
+		output = map[string]bool{*meetupGroupID: true}
+	}
+	for index, value := range map[string]*string{"country": country, "city": city} {
+		if value == nil {
+			continue
+		}
+		found := map[string]bool{}
+		it, err := txn.Get("meetupGroup", index, *value)
+		if err != nil {
+			return nil, err
+		}
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			found[obj.(models.MeetupGroup).MeetupID] = true
+		}
+		output = intersectStrings(output, found)
+	}
+	return output, nil
+}
+
+func meetupsOfMeetupGroups(txn *memdb.Txn, meetupGroupIDs map[string]bool) (map[int]bool, error) {
+	output := map[int]bool{}
+	for id := range meetupGroupIDs {
+		relations, err := txn.Get("meetupGroupToMeetup", "meetupGroupID", id)
+		if err != nil {
+			return nil, err
+		}
+		for obj := relations.Next(); obj != nil; obj = relations.Next() {
+			output[obj.(models.MeetupGroupToMeetup).MeetupID] = true
+		}
+	}
+	return output, nil
+}
+
+func meetupsSponsoredBy(txn *memdb.Txn, companyID string) (map[int]bool, error) {
+	output := map[int]bool{}
+	sponsorRelations, err := txn.Get("sponsorToCompany", "companyID", companyID)
+	if err != nil {
+		return nil, err
+	}
+	for sponsorRelObj := sponsorRelations.Next(); sponsorRelObj != nil; sponsorRelObj = sponsorRelations.Next() {
+		sponsorRel := sponsorRelObj.(models.SponsorToCompany)
+		meetupRelations, err := txn.Get("meetupToSponsor", "sponsorID", sponsorRel.SponsorID)
+		if err != nil {
+			return nil, err
+		}
+		for meetRelObj := meetupRelations.Next(); meetRelObj != nil; meetRelObj = meetupRelations.Next() {
+			output[meetRelObj.(models.MeetupToSponsor).MeetupID] = true
+		}
+	}
+	return output, nil
+}
+
+//speakersPresentingIn returns the IDs of the speakers that presented at a meetup in the country
+func speakersPresentingIn(txn *memdb.Txn, country string) (map[string]bool, error) {
+	output := map[string]bool{}
+	meetupGroupIDs, err := findMeetupGroups(txn, &country, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for meetupGroupID := range meetupGroupIDs {
+		presentations, err := txn.Get("presentation", "meetupGroupID", meetupGroupID)
+		if err != nil {
+			return nil, err
+		}
+		for presObj := presentations.Next(); presObj != nil; presObj = presentations.Next() {
+			speakerRelations, err := txn.Get("presentationToSpeaker", "presentationID", presObj.(models.Presentation).ID)
+			if err != nil {
+				return nil, err
+			}
+			for speakRelObj := speakerRelations.Next(); speakRelObj != nil; speakRelObj = speakerRelations.Next() {
+				output[speakRelObj.(models.PresentationToSpeaker).SpeakerID] = true
+			}
+		}
+	}
+	return output, nil
+}
+
+//presentationsTitled returns the IDs of the presentations with title words starting with all words of the
+//keyword, looked up in the search index. Without any words in the keyword, all presentations match.
+func presentationsTitled(txn *memdb.Txn, keyword string) (map[string]bool, error) {
+	var output map[string]bool
+	for _, term := range search.Tokenize(keyword) {
+		found := map[string]bool{}
+		postings, err := txn.Get("searchTerm", "term_prefix", term)
+		if err != nil {
+			return nil, err
+		}
+		for obj := postings.Next(); obj != nil; obj = postings.Next() {
+			docObj, err := txn.First("searchDocument", "id", obj.(models.SearchTerm).DocumentID)
+			if err != nil {
+				return nil, err
+			}
+			document := docObj.(models.SearchDocument)
+			if document.Type == string(models.SearchTypePresentation) && document.Field == "title" {
+				found[document.NodeID] = true
+			}
+		}
+		output = intersectStrings(output, found)
+	}
+	return output, nil
+}
+
+//intersectStrings returns the IDs in both sets, where a nil set holds all IDs
+func intersectStrings(a, b map[string]bool) map[string]bool {
+	if a == nil {
+		return b
+	}
+	output := map[string]bool{}
+	for id := range a {
+		if b[id] {
+			output[id] = true
+		}
+	}
+	return output
+}
+
+//intersectInts returns the IDs in both sets, where a nil set holds all IDs
+func intersectInts(a, b map[int]bool) map[int]bool {
+	if a == nil {
+		return b
+	}
+	output := map[int]bool{}
+	for id := range a {
+		if b[id] {
+			output[id] = true
+		}
+	}
+	return output
+}
+
 // ### Helpers ###
+
+//stringIDs returns the IDs of the set as the IDs of a Listing, a nil set lists all nodes
+func stringIDs(set map[string]bool) []interface{} {
+	if set == nil {
+		return nil
+	}
+	output := make([]interface{}, 0, len(set))
+	for id := range set {
+		output = append(output, id)
+	}
+	return output
+}
+
 func contains(slice []*string, item *string) bool {
 	set := make(map[string]struct{}, len(slice))
 	for _, s := range slice {
@@ -0,0 +1,101 @@
+package search
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var tagRegexp = regexp.MustCompile(`<[^>]*>`)
+
+// Tokenize splits text into lowercase terms, the terms are used both for indexing and for querying
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// StripHTML returns the text of an HTML fragment, like the description of a meetup group
+func StripHTML(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(tagRegexp.ReplaceAllString(s, " "))), " ")
+}
+
+// Snippet returns an HTML-escaped excerpt of text of about size runes around the first match,
+// with the words matching the terms wrapped in <em> tags. A term matches words starting with it.
+func Snippet(text string, terms []string, size int) string {
+	type word struct {
+		start, end int
+		match      bool
+	}
+	runes := []rune(text)
+	words := []word{}
+	first := -1
+	for i := 0; i < len(runes); {
+		if !unicode.IsLetter(runes[i]) && !unicode.IsDigit(runes[i]) {
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])) {
+			j++
+		}
+		w := word{start: i, end: j, match: matches(strings.ToLower(string(runes[i:j])), terms)}
+		if w.match && first < 0 {
+			first = i
+		}
+		words = append(words, w)
+		i = j
+	}
+
+	// Center the excerpt on the first match, without cutting words in half
+	from, to := 0, len(runes)
+	if len(runes) > size {
+		if first > size/3 {
+			from = first - size/3
+		}
+		to = from + size
+		if to > len(runes) {
+			to, from = len(runes), len(runes)-size
+		}
+		for _, w := range words {
+			if w.start < from && w.end > from {
+				from = w.end
+			}
+			if w.start < to && w.end > to {
+				to = w.start
+			}
+		}
+		for from < to && !unicode.IsLetter(runes[from]) && !unicode.IsDigit(runes[from]) && !unicode.IsPunct(runes[from]) {
+			from++
+		}
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	pos := from
+	for _, w := range words {
+		if !w.match || w.start < from || w.end > to {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:w.start])))
+		b.WriteString("<em>" + html.EscapeString(string(runes[w.start:w.end])) + "</em>")
+		pos = w.end
+	}
+	b.WriteString(html.EscapeString(strings.TrimRightFunc(string(runes[pos:to]), unicode.IsSpace)))
+	if to < len(runes) {
+		b.WriteString("…")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func matches(word string, terms []string) bool {
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	return false
+}
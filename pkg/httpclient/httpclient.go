@@ -0,0 +1,261 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Options configure a Client
+type Options struct {
+	// Timeout is the deadline of a single attempt, including reading the response body
+	Timeout time.Duration
+	// MaxRetries is how often a failed request is retried
+	MaxRetries int
+	// MinBackoff is the wait before the first retry, it doubles for every following retry
+	MinBackoff time.Duration
+	// MaxBackoff is the longest wait between two attempts
+	MaxBackoff time.Duration
+	// Limiter limits the rate of the requests, and can be shared between clients. A nil Limiter doesn't limit.
+	Limiter *Limiter
+}
+
+// DefaultLimiter limits the requests of the Default client, staying below the limits of meetup.com
+var DefaultLimiter = NewLimiter(5, 10)
+
+// Default is the client used for all requests to meetup.com
+var Default = New(Options{
+	Timeout:    30 * time.Second,
+	MaxRetries: 4,
+	MinBackoff: time.Second,
+	MaxBackoff: time.Minute,
+	Limiter:    DefaultLimiter,
+})
+
+// Client sends HTTP requests with a deadline per attempt, and retries the requests that failed
+// because of network errors, throttling (429 Too Many Requests) or server errors (5xx)
+type Client struct {
+	opts   Options
+	client *http.Client
+}
+
+// New returns a client with the given options
+func New(opts Options) *Client {
+	return &Client{opts: opts, client: &http.Client{}}
+}
+
+// StatusError is returned for responses with a status code of 400 and above
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Status     string
+	// Body is the start of the response body, which usually describes the error
+	Body string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s responded %s: %s", e.URL, e.Status, e.Body)
+}
+
+// Do sends the request. Requests with a body must set GetBody for being retried, like
+// http.NewRequest does. All requests are retried, so they must be safe to repeat.
+// Responses with a status code of 400 and above are returned as *StatusError.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	backoff := c.opts.MinBackoff
+	body := req.Body
+	for attempt := 0; ; attempt++ {
+		if err := c.opts.Limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		resp, err := c.attempt(req, body)
+		retry, wait := c.shouldRetry(ctx, resp, err)
+		if !retry || attempt >= c.opts.MaxRetries {
+			if err != nil {
+				return nil, err
+			}
+			if resp.StatusCode >= 400 {
+				return nil, newStatusError(req, resp)
+			}
+			return resp, nil
+		}
+
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			resp.Body.Close()
+		}
+		// Wait as long as the server asked for, or else back off exponentially
+		if wait <= 0 {
+			// Add some jitter, for concurrent requests not to retry at the same time
+			wait = backoff + time.Duration(rand.Int63n(int64(backoff)/5+1))
+			if wait > c.opts.MaxBackoff {
+				wait = c.opts.MaxBackoff
+			}
+			backoff *= 2
+		}
+		log.Debugf("Retrying %s %s in %s (%s)", req.Method, req.URL, wait, reason)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if req.GetBody != nil {
+			if body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// attempt sends the request once with the given body, with a deadline covering reading the response body
+func (c *Client) attempt(req *http.Request, body io.ReadCloser) (*http.Response, error) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if c.opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), c.opts.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(req.Context())
+	}
+	r := req.WithContext(ctx)
+	r.Body = body
+	resp, err := c.client.Do(r)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	c.updateLimiter(resp)
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// shouldRetry tells whether to retry the request, and how long the server asked to wait
+func (c *Client) shouldRetry(ctx context.Context, resp *http.Response, err error) (bool, time.Duration) {
+	if err != nil {
+		// Don't retry when the caller gave up
+		return ctx.Err() == nil, 0
+	}
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+		return false, 0
+	}
+	if resp.StatusCode == http.StatusNotImplemented || resp.StatusCode == http.StatusHTTPVersionNotSupported {
+		return false, 0
+	}
+	return true, retryAfter(resp, time.Now())
+}
+
+// updateLimiter pauses all requests until the rate limit resets, when the server says that it is exhausted
+func (c *Client) updateLimiter(resp *http.Response) {
+	if resp.Header.Get("X-RateLimit-Remaining") != "0" {
+		return
+	}
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		c.opts.Limiter.PauseUntil(time.Now().Add(time.Duration(reset) * time.Second))
+	}
+}
+
+// retryAfter returns the wait requested by the Retry-After header, given in seconds or as a date,
+// or else by the X-RateLimit-Reset header in seconds
+func retryAfter(resp *http.Response, now time.Time) time.Duration {
+	if value := resp.Header.Get("Retry-After"); len(value) != 0 {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+		if t, err := http.ParseTime(value); err == nil {
+			return t.Sub(now)
+		}
+	}
+	if seconds, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	return 0
+}
+
+func newStatusError(req *http.Request, resp *http.Response) error {
+	defer resp.Body.Close()
+	b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	return &StatusError{
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       strings.TrimSpace(string(b)),
+	}
+}
+
+// cancelOnClose ends the deadline of an attempt when the body of the response is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// GetJSON gets the JSON document at url, and decodes it into v
+func (c *Client) GetJSON(ctx context.Context, url string, v interface{}) error {
+	_, err := c.GetJSONPage(ctx, url, v)
+	return err
+}
+
+// GetJSONPage is like GetJSON for a page of a list, and returns the URL of the next page
+// from the Link header, or "" if it's the last page
+func (c *Client) GetJSONPage(ctx context.Context, url string, v interface{}) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return "", fmt.Errorf("GetJSON failed for url %s with error %v", url, err)
+	}
+	return nextLink(req.URL, resp.Header), nil
+}
+
+// nextLink returns the link with rel="next" from the Link headers (RFC 8288), e.g.
+// <https://api.meetup.com/...&scroll=next>; rel="next", resolved against base
+func nextLink(base *neturl.URL, header http.Header) string {
+	for _, value := range header["Link"] {
+		for _, link := range strings.Split(value, ",") {
+			params := strings.Split(link, ";")
+			target := strings.TrimSpace(params[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range params[1:] {
+				kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+				if len(kv) != 2 || !strings.EqualFold(kv[0], "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(kv[1], `"`)) {
+					if !strings.EqualFold(rel, "next") {
+						continue
+					}
+					u, err := base.Parse(target[1 : len(target)-1])
+					if err != nil {
+						return ""
+					}
+					return u.String()
+				}
+			}
+		}
+	}
+	return ""
+}
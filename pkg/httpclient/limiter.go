@@ -0,0 +1,79 @@
+package httpclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket limiting the rate of requests. The bucket holds up to burst tokens,
+// is refilled with rate tokens per second, and every request takes a token.
+type Limiter struct {
+	rate  float64
+	burst float64
+
+	mux    sync.Mutex
+	tokens float64
+	last   time.Time
+	// pausedUntil is when the server allows requests again, after its rate limit was exhausted
+	pausedUntil time.Time
+}
+
+// NewLimiter returns a limiter allowing rate requests per second, with bursts of up to burst requests
+func NewLimiter(rate float64, burst int) *Limiter {
+	return &Limiter{rate: rate, burst: float64(burst), tokens: float64(burst)}
+}
+
+// Wait blocks until a request may be sent, or the context is done. A nil Limiter never blocks.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	wait := l.reserve(time.Now())
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token, and returns how long to wait until it's available
+func (l *Limiter) reserve(now time.Time) time.Duration {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	// The token is taken right away, the waiting requests queue up by going into debt
+	l.tokens--
+	wait := time.Duration(0)
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	if pause := l.pausedUntil.Sub(now); pause > wait {
+		wait = pause
+	}
+	return wait
+}
+
+// PauseUntil holds back all requests until t, e.g. when the server said that its rate limit is exhausted
+func (l *Limiter) PauseUntil(t time.Time) {
+	if l == nil {
+		return
+	}
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	if t.After(l.pausedUntil) {
+		l.pausedUntil = t
+	}
+}
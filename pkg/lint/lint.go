@@ -0,0 +1,218 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+type Severity string
+
+var (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+
+	validSeverities = map[Severity]struct{}{
+		SeverityError:   {},
+		SeverityWarning: {},
+		SeverityInfo:    {},
+	}
+)
+
+type OutputFormat string
+
+var (
+	OutputFormatText   OutputFormat = "text"
+	OutputFormatJSON   OutputFormat = "json"
+	OutputFormatGitHub OutputFormat = "github"
+)
+
+// Options for the linter
+type Options struct {
+	// ConfigFile points to the lint configuration file. It's fine if it doesn't exist.
+	ConfigFile string
+	// Output is the format to write the findings in
+	Output OutputFormat
+	// CompaniesFile and SpeakersFile point to the files the configuration was read from
+	CompaniesFile string
+	SpeakersFile  string
+}
+
+// Config is the format of the lint configuration file
+type Config struct {
+	// Disabled lists the IDs of the rules that shouldn't be run
+	Disabled []string `json:"disabled,omitempty"`
+	// Severities overrides the default severity of rules by ID
+	Severities map[string]Severity `json:"severities,omitempty"`
+	// RecordingAfterDays is the amount of days after which a meetup should have a recording
+	RecordingAfterDays int `json:"recordingAfterDays,omitempty"`
+}
+
+// Finding is a problem found by a rule
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	File     string   `json:"file,omitempty"`
+	Path     string   `json:"path,omitempty"`
+	Message  string   `json:"message"`
+}
+
+// Rule is a semantic check over a loaded configuration
+type Rule struct {
+	// ID identifies the rule in the configuration file and the output
+	ID string
+	// Severity is the default severity of the findings of this rule
+	Severity Severity
+	// Description explains what the rule checks
+	Description string
+	// Check runs the rule and returns its findings. The severity of the findings is set by the linter.
+	Check func(c *checkContext) []Finding
+}
+
+// checkContext is the input for the rules
+type checkContext struct {
+	cfg      *types.Config
+	problems types.ValidationErrors
+	opts     *Options
+	lintCfg  *Config
+	now      time.Time
+}
+
+// LoadConfig reads the lint configuration file. A missing file gives the default configuration.
+func LoadConfig(path string) (*Config, error) {
+	cfg := &Config{
+		RecordingAfterDays: 30,
+	}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := yaml.UnmarshalStrict(b, cfg); err != nil {
+		return nil, fmt.Errorf("couldn't read lint configuration %s: %v", path, err)
+	}
+	known := map[string]bool{}
+	for _, r := range Rules {
+		known[r.ID] = true
+	}
+	for _, id := range cfg.Disabled {
+		if !known[id] {
+			return nil, fmt.Errorf("%s: unknown rule %q", path, id)
+		}
+	}
+	for id, sev := range cfg.Severities {
+		if !known[id] {
+			return nil, fmt.Errorf("%s: unknown rule %q", path, id)
+		}
+		if _, ok := validSeverities[sev]; !ok {
+			return nil, fmt.Errorf("%s: not a valid severity for rule %q: %q", path, id, sev)
+		}
+	}
+	return cfg, nil
+}
+
+// Lint runs all enabled rules over the configuration. problems are the
+// ValidationErrors returned next to the configuration by generator.Load.
+func Lint(cfg *types.Config, problems types.ValidationErrors, opts *Options, lintCfg *Config) []Finding {
+	disabled := map[string]bool{}
+	for _, id := range lintCfg.Disabled {
+		disabled[id] = true
+	}
+	c := &checkContext{
+		cfg:      cfg,
+		problems: problems,
+		opts:     opts,
+		lintCfg:  lintCfg,
+		now:      time.Now().UTC(),
+	}
+	findings := []Finding{}
+	for _, r := range Rules {
+		if disabled[r.ID] {
+			continue
+		}
+		severity := r.Severity
+		if sev, ok := lintCfg.Severities[r.ID]; ok {
+			severity = sev
+		}
+		for _, f := range r.Check(c) {
+			f.Rule = r.ID
+			f.Severity = severity
+			findings = append(findings, f)
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		return findings[i].Path < findings[j].Path
+	})
+	return findings
+}
+
+// HasErrors returns whether any of the findings has the error severity
+func HasErrors(findings []Finding) bool {
+	for _, f := range findings {
+		if f.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Write writes the findings to out in the given format
+func Write(out io.Writer, findings []Finding, format OutputFormat) error {
+	switch format {
+	case OutputFormatText, "":
+		for _, f := range findings {
+			location := f.File
+			if len(f.Path) != 0 {
+				location += ": " + f.Path
+			}
+			fmt.Fprintf(out, "%s: %s [%s] %s\n", location, f.Severity, f.Rule, f.Message)
+		}
+		fmt.Fprintf(out, "%d finding(s)\n", len(findings))
+	case OutputFormatJSON:
+		b, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(b))
+	case OutputFormatGitHub:
+		// See https://docs.github.com/en/actions/reference/workflow-commands-for-github-actions
+		for _, f := range findings {
+			command := "notice"
+			switch f.Severity {
+			case SeverityError:
+				command = "error"
+			case SeverityWarning:
+				command = "warning"
+			}
+			msg := f.Message
+			if len(f.Path) != 0 {
+				msg = f.Path + ": " + msg
+			}
+			fmt.Fprintf(out, "::%s file=%s,title=%s::%s\n", command, escapeProperty(f.File), escapeProperty(f.Rule), escapeData(msg))
+		}
+	default:
+		return fmt.Errorf("invalid output format: %s", format)
+	}
+	return nil
+}
+
+func escapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+func escapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
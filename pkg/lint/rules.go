@@ -0,0 +1,289 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+// Rules lists all available rules, in the order they are run
+var Rules = []Rule{
+	{
+		ID:          "duplicate-id",
+		Severity:    SeverityError,
+		Description: "A company or speaker ID, or a presentation ID within a meetup, is used more than once",
+		Check:       checkDuplicateIDs,
+	},
+	{
+		ID:          "numeric-presentation-id",
+		Severity:    SeverityError,
+		Description: "A presentation ID is a plain number, which would clash with the position of another presentation",
+		Check:       checkNumericPresentationIDs,
+	},
+	{
+		ID:          "unresolved-reference",
+		Severity:    SeverityError,
+		Description: "A company or speaker is referenced that doesn't exist",
+		Check:       checkUnresolvedReferences,
+	},
+	{
+		ID:          "organizer-not-in-speakers",
+		Severity:    SeverityError,
+		Description: "An organizer of a meetup group isn't listed in speakers.yaml",
+		Check:       checkOrganizersNotInSpeakers,
+	},
+	{
+		ID:          "presentation-overrun",
+		Severity:    SeverityWarning,
+		Description: "The agenda of a meetup continues after the end time of the meetup",
+		Check:       checkPresentationOverrun,
+	},
+	{
+		ID:          "speaker-without-company",
+		Severity:    SeverityInfo,
+		Description: "A speaker doesn't have a company set",
+		Check:       checkSpeakersWithoutCompany,
+	},
+	{
+		ID:          "unknown-sponsor-role",
+		Severity:    SeverityError,
+		Description: "A sponsor of a meetup doesn't have one of the known roles",
+		Check:       checkUnknownSponsorRoles,
+	},
+	{
+		ID:          "missing-recording",
+		Severity:    SeverityWarning,
+		Description: "A meetup doesn't have a recording a given amount of days (recordingAfterDays) after it took place",
+		Check:       checkMissingRecordings,
+	},
+	{
+		ID:          "unmatched-meetup",
+		Severity:    SeverityWarning,
+		Description: "A meetup doesn't match any event fetched for the meetup group, by its eventID or date",
+		Check:       checkUnmatchedMeetups,
+	},
+	{
+		ID:          "unmatched-event",
+		Severity:    SeverityWarning,
+		Description: "An event fetched for the meetup group isn't listed in its meetups, nor ignored with ignoreEvents",
+		Check:       checkUnmatchedEvents,
+	},
+	{
+		ID:          "unused-company",
+		Severity:    SeverityWarning,
+		Description: "A company isn't referenced by any speaker, sponsor or ecosystem member",
+		Check:       checkUnusedCompanies,
+	},
+	{
+		ID:          "unused-speaker",
+		Severity:    SeverityWarning,
+		Description: "A speaker is neither organizing a meetup group nor presenting at a meetup",
+		Check:       checkUnusedSpeakers,
+	},
+}
+
+func checkDuplicateIDs(c *checkContext) []Finding {
+	findings := []Finding{}
+	for _, p := range c.problems {
+		switch p.Kind {
+		case types.ValidationErrorDuplicateCompany, types.ValidationErrorDuplicateSpeaker, types.ValidationErrorDuplicatePresentation:
+			findings = append(findings, Finding{File: p.File, Path: p.Path, Message: fmt.Sprintf("ID %q is used more than once", p.ID)})
+		}
+	}
+	return findings
+}
+
+func checkNumericPresentationIDs(c *checkContext) []Finding {
+	findings := []Finding{}
+	for _, p := range c.problems {
+		if p.Kind == types.ValidationErrorNumericPresentation {
+			findings = append(findings, Finding{File: p.File, Path: p.Path, Message: fmt.Sprintf("Presentation ID %q is a plain number, use e.g. a word instead", p.ID)})
+		}
+	}
+	return findings
+}
+
+func isOrganizerProblem(p types.ValidationError) bool {
+	return p.Kind == types.ValidationErrorUnknownSpeaker && strings.HasPrefix(p.Path, "organizers[")
+}
+
+func checkUnresolvedReferences(c *checkContext) []Finding {
+	findings := []Finding{}
+	for _, p := range c.problems {
+		if isOrganizerProblem(p) {
+			continue
+		}
+		switch p.Kind {
+		case types.ValidationErrorUnknownCompany:
+			findings = append(findings, Finding{File: p.File, Path: p.Path, Message: fmt.Sprintf("Company %q doesn't exist", p.ID)})
+		case types.ValidationErrorUnknownSpeaker:
+			findings = append(findings, Finding{File: p.File, Path: p.Path, Message: fmt.Sprintf("Speaker %q doesn't exist", p.ID)})
+		}
+	}
+	return findings
+}
+
+func checkOrganizersNotInSpeakers(c *checkContext) []Finding {
+	findings := []Finding{}
+	for _, p := range c.problems {
+		if isOrganizerProblem(p) {
+			findings = append(findings, Finding{File: p.File, Path: p.Path, Message: fmt.Sprintf("Organizer %q isn't listed in %s", p.ID, c.opts.SpeakersFile)})
+		}
+	}
+	return findings
+}
+
+func checkPresentationOverrun(c *checkContext) []Finding {
+	findings := []Finding{}
+	for _, mg := range c.cfg.MeetupGroups {
+		for _, key := range sortedMeetupKeys(mg) {
+			m := mg.Meetups[key]
+			if m.AutogenMeetup == nil || m.Duration.Duration == 0 {
+				continue
+			}
+			end := m.Date.Add(m.Duration.Duration)
+			for i, p := range m.Presentations {
+				if p.End.After(end) {
+					findings = append(findings, Finding{
+						File:    mg.File,
+						Path:    fmt.Sprintf("meetups.%s.presentations[%d]", key, i),
+						Message: fmt.Sprintf("Presentation %q ends at %s, after the meetup ends at %s", p.Title, p.EndTime(), formatClock(end)),
+					})
+				}
+			}
+		}
+	}
+	return findings
+}
+
+func checkSpeakersWithoutCompany(c *checkContext) []Finding {
+	findings := []Finding{}
+	for i, s := range c.cfg.Speakers {
+		if len(s.Company.RefID()) == 0 {
+			findings = append(findings, Finding{File: c.opts.SpeakersFile, Path: fmt.Sprintf("[%d].company", i), Message: fmt.Sprintf("Speaker %q doesn't have a company", s.ID)})
+		}
+	}
+	return findings
+}
+
+func checkUnknownSponsorRoles(c *checkContext) []Finding {
+	findings := []Finding{}
+	for _, p := range c.problems {
+		if p.Kind == types.ValidationErrorUnknownSponsorRole {
+			findings = append(findings, Finding{File: p.File, Path: p.Path, Message: fmt.Sprintf("Unknown sponsor role %q", p.ID)})
+		}
+	}
+	return findings
+}
+
+func checkMissingRecordings(c *checkContext) []Finding {
+	findings := []Finding{}
+	gracePeriod := time.Duration(c.lintCfg.RecordingAfterDays) * 24 * time.Hour
+	for _, mg := range c.cfg.MeetupGroups {
+		for _, key := range sortedMeetupKeys(mg) {
+			m := mg.Meetups[key]
+			if m.AutogenMeetup == nil || len(m.Recording) != 0 {
+				continue
+			}
+			if m.Date.Add(m.Duration.Duration).Add(gracePeriod).Before(c.now) {
+				findings = append(findings, Finding{
+					File:    mg.File,
+					Path:    fmt.Sprintf("meetups.%s.recording", key),
+					Message: fmt.Sprintf("Meetup %q doesn't have a recording %d days after it took place", m.Name, c.lintCfg.RecordingAfterDays),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func checkUnmatchedMeetups(c *checkContext) []Finding {
+	findings := []Finding{}
+	for _, mg := range c.cfg.MeetupGroups {
+		for _, m := range mg.Unmatched.Meetups {
+			findings = append(findings, Finding{
+				File:    mg.File,
+				Path:    fmt.Sprintf("meetups.%s", m.Key),
+				Message: fmt.Sprintf("Meetup %q doesn't match any event: %s", m.Key, m.Reason),
+			})
+		}
+	}
+	return findings
+}
+
+func checkUnmatchedEvents(c *checkContext) []Finding {
+	findings := []Finding{}
+	for _, mg := range c.cfg.MeetupGroups {
+		for _, ev := range mg.Unmatched.Events {
+			findings = append(findings, Finding{
+				File:    mg.File,
+				Path:    "meetups",
+				Message: fmt.Sprintf("Event %d %q on %s isn't listed, add it with eventID: %d or ignore it with ignoreEvents", ev.ID, ev.Name, ev.Date.YYYYMMDD(), ev.ID),
+			})
+		}
+	}
+	return findings
+}
+
+func checkUnusedCompanies(c *checkContext) []Finding {
+	used := map[types.CompanyID]bool{}
+	for _, s := range c.cfg.Speakers {
+		used[s.Company.RefID()] = true
+	}
+	for _, mg := range c.cfg.MeetupGroups {
+		for _, e := range mg.EcosystemMembers {
+			used[e.RefID()] = true
+		}
+		for _, m := range mg.Meetups {
+			for _, s := range m.Sponsors {
+				used[s.Company.RefID()] = true
+			}
+		}
+	}
+	findings := []Finding{}
+	for i, company := range c.cfg.Companies {
+		if !used[company.ID] {
+			findings = append(findings, Finding{File: c.opts.CompaniesFile, Path: fmt.Sprintf("[%d]", i), Message: fmt.Sprintf("Company %q isn't referenced anywhere", company.ID)})
+		}
+	}
+	return findings
+}
+
+func checkUnusedSpeakers(c *checkContext) []Finding {
+	used := map[types.SpeakerID]bool{}
+	for _, mg := range c.cfg.MeetupGroups {
+		for _, o := range mg.Organizers {
+			used[o.RefID()] = true
+		}
+		for _, m := range mg.Meetups {
+			for _, p := range m.Presentations {
+				for _, s := range p.Speakers {
+					used[s.RefID()] = true
+				}
+			}
+		}
+	}
+	findings := []Finding{}
+	for i, s := range c.cfg.Speakers {
+		if !used[s.ID] {
+			findings = append(findings, Finding{File: c.opts.SpeakersFile, Path: fmt.Sprintf("[%d]", i), Message: fmt.Sprintf("Speaker %q isn't organizing or presenting anywhere", s.ID)})
+		}
+	}
+	return findings
+}
+
+func sortedMeetupKeys(mg types.MeetupGroup) []string {
+	keys := make([]string, 0, len(mg.Meetups))
+	for key := range mg.Meetups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatClock(t time.Time) string {
+	return fmt.Sprintf("%d:%02d", t.Hour(), t.Minute())
+}
@@ -0,0 +1,161 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+)
+
+const draft = "http://json-schema.org/draft-07/schema#"
+
+// Schema is a subset of JSON Schema (draft 7), enough to describe the configuration files
+type Schema struct {
+	Schema      string             `json:"$schema,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	// AdditionalProperties is either false or a *Schema for the values of a map
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+	Items                *Schema     `json:"items,omitempty"`
+	Enum                 []string    `json:"enum,omitempty"`
+	Format               string      `json:"format,omitempty"`
+	Pattern              string      `json:"pattern,omitempty"`
+	// hintEnum makes Enum only a hint for editors, the values are checked after parsing instead
+	hintEnum bool
+	// pattern is Pattern, compiled when the schema is built. Without it, Validate compiles Pattern itself
+	pattern *regexp.Regexp
+}
+
+// durationPattern matches the durations understood by time.ParseDuration, e.g. "1h30m"
+const durationPattern = `^([0-9]+(\.[0-9]*)?(ns|us|µs|ms|s|m|h))+$`
+
+var durationRegexp = regexp.MustCompile(durationPattern)
+
+// Known maps the names of the configuration files to their schemas
+var Known = map[string]func() *Schema{
+	"meetup":    MeetupGroup,
+	"speakers":  Speakers,
+	"companies": Companies,
+}
+
+// MeetupGroup returns the schema for a meetup.yaml file
+func MeetupGroup() *Schema {
+	s := forType(reflect.TypeOf(types.MeetupGroup{}))
+	s.Schema = draft
+	s.Title = "meetup.yaml"
+	s.Description = "The hand-written information about a meetup group and its meetups"
+	return s
+}
+
+// Speakers returns the schema for the speakers.yaml file
+func Speakers() *Schema {
+	s := forType(reflect.TypeOf([]types.Speaker{}))
+	s.Schema = draft
+	s.Title = "speakers.yaml"
+	s.Description = "All speakers and organizers of the meetup groups"
+	return s
+}
+
+// Companies returns the schema for the companies.yaml file
+func Companies() *Schema {
+	s := forType(reflect.TypeOf([]types.Company{}))
+	s.Schema = draft
+	s.Title = "companies.yaml"
+	s.Description = "All companies sponsoring or otherwise related to the meetup groups"
+	return s
+}
+
+// forType derives the schema for t from its JSON representation. Fields can be
+// annotated with a `jsonschema` tag; "required" marks a field as required and
+// "-" leaves the field out, e.g. for data that isn't written by hand.
+func forType(t reflect.Type) *Schema {
+	switch t {
+	case reflect.TypeOf(types.CompanyRef{}):
+		return &Schema{Type: "string", Description: "ID of a company in companies.yaml"}
+	case reflect.TypeOf(types.SpeakerRef{}):
+		return &Schema{Type: "string", Description: "ID of a speaker in speakers.yaml"}
+	case reflect.TypeOf(types.Duration{}):
+		return &Schema{Type: "string", Description: "A duration like 30m or 1h15m", Pattern: durationPattern, pattern: durationRegexp}
+	case reflect.TypeOf(types.Time{}):
+		return &Schema{Type: "string", Description: "An RFC3339 timestamp", Format: "date-time"}
+	case reflect.TypeOf(types.Date("")):
+		return &Schema{Type: "string", Description: "A date like 2019-03-12", Format: "date"}
+	case reflect.TypeOf(types.SponsorRole("")):
+		// Unknown roles are reported by the Registry, for lint to be able to report them as a rule
+		return &Schema{Type: "string", Enum: sponsorRoles(), hintEnum: true}
+	case reflect.TypeOf(types.EventSourceType("")):
+		return &Schema{Type: "string", Enum: []string{string(types.EventSourceMeetupDotCom), string(types.EventSourceMeetupDotComREST), string(types.EventSourceFile)}}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return forType(t.Elem())
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: forType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: forType(t.Elem())}
+	case reflect.Struct:
+		s := &Schema{Type: "object", Properties: map[string]*Schema{}, AdditionalProperties: false}
+		addFields(s, t)
+		sort.Strings(s.Required)
+		return s
+	default:
+		panic(fmt.Sprintf("schema: unsupported type %s", t))
+	}
+}
+
+// addFields adds the properties of the struct type t to s, inlining embedded structs
+func addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		jsonTag := f.Tag.Get("json")
+		schemaTag := f.Tag.Get("jsonschema")
+		if jsonTag == "-" || schemaTag == "-" {
+			continue
+		}
+		name := strings.Split(jsonTag, ",")[0]
+		if f.Anonymous && len(name) == 0 {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			addFields(s, ft)
+			continue
+		}
+		if len(f.PkgPath) != 0 {
+			// unexported
+			continue
+		}
+		if len(name) == 0 {
+			name = f.Name
+		}
+		s.Properties[name] = forType(f.Type)
+		if schemaTag == "required" {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+func sponsorRoles() []string {
+	roles := make([]string, 0, len(types.ValidSponsorRoles))
+	for role := range types.ValidSponsorRoles {
+		roles = append(roles, string(role))
+	}
+	sort.Strings(roles)
+	return roles
+}
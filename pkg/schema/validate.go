@@ -0,0 +1,185 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloud-native-nordics/meetup-kit/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Validate checks the YAML document in content against s, and returns all
+// problems found with the line and column they were found at
+func Validate(file string, content []byte, s *Schema) types.ValidationErrors {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(content, doc); err != nil {
+		return types.ValidationErrors{{File: file, Kind: types.ValidationErrorParse, Message: err.Error()}}
+	}
+	// An empty file
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	v := &validator{file: file, patterns: map[string]*regexp.Regexp{}}
+	v.validate(doc.Content[0], s, "")
+	return v.errs
+}
+
+type validator struct {
+	file string
+	errs types.ValidationErrors
+	// patterns caches the patterns of the schemas that aren't compiled yet
+	patterns map[string]*regexp.Regexp
+}
+
+// pattern returns the compiled Pattern of s, or an error if it isn't a valid regular expression
+func (v *validator) pattern(s *Schema) (*regexp.Regexp, error) {
+	if s.pattern != nil {
+		return s.pattern, nil
+	}
+	if re, ok := v.patterns[s.Pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(s.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	v.patterns[s.Pattern] = re
+	return re, nil
+}
+
+func (v *validator) errorf(n *yaml.Node, path string, format string, args ...interface{}) {
+	v.errs = append(v.errs, types.ValidationError{
+		File:    v.file,
+		Line:    n.Line,
+		Column:  n.Column,
+		Path:    path,
+		Kind:    types.ValidationErrorSchema,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) validate(n *yaml.Node, s *Schema, path string) {
+	if n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	// Values can always be left empty
+	if n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		if n.Kind != yaml.MappingNode {
+			v.errorf(n, path, "expected an object, got %s", describe(n))
+			return
+		}
+		v.validateObject(n, s, path)
+	case "array":
+		if n.Kind != yaml.SequenceNode {
+			v.errorf(n, path, "expected a list, got %s", describe(n))
+			return
+		}
+		for i, item := range n.Content {
+			v.validate(item, s.Items, fmt.Sprintf("%s[%d]", path, i))
+		}
+	case "string":
+		// Other scalars are converted to strings when unmarshalling
+		if n.Kind != yaml.ScalarNode {
+			v.errorf(n, path, "expected a string, got %s", describe(n))
+			return
+		}
+		v.validateString(n, s, path)
+	case "integer":
+		if n.Kind != yaml.ScalarNode || n.Tag != "!!int" {
+			v.errorf(n, path, "expected an integer, got %s", describe(n))
+		}
+	case "number":
+		if n.Kind != yaml.ScalarNode || (n.Tag != "!!int" && n.Tag != "!!float") {
+			v.errorf(n, path, "expected a number, got %s", describe(n))
+		}
+	case "boolean":
+		if n.Kind != yaml.ScalarNode || n.Tag != "!!bool" {
+			v.errorf(n, path, "expected true or false, got %s", describe(n))
+		}
+	}
+}
+
+func (v *validator) validateObject(n *yaml.Node, s *Schema, path string) {
+	seen := map[string]bool{}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, value := n.Content[i], n.Content[i+1]
+		fieldPath := key.Value
+		if len(path) != 0 {
+			fieldPath = path + "." + key.Value
+		}
+		seen[key.Value] = true
+		if prop, ok := s.Properties[key.Value]; ok {
+			v.validate(value, prop, fieldPath)
+			continue
+		}
+		if additional, ok := s.AdditionalProperties.(*Schema); ok {
+			v.validate(value, additional, fieldPath)
+			continue
+		}
+		v.errorf(key, fieldPath, "unknown field %q, expected one of: %s", key.Value, strings.Join(propertyNames(s), ", "))
+	}
+	for _, name := range s.Required {
+		if !seen[name] {
+			v.errorf(n, path, "missing required field %q", name)
+		}
+	}
+}
+
+func (v *validator) validateString(n *yaml.Node, s *Schema, path string) {
+	if len(s.Enum) != 0 && !s.hintEnum {
+		found := false
+		for _, e := range s.Enum {
+			if e == n.Value {
+				found = true
+			}
+		}
+		if !found {
+			v.errorf(n, path, "%q is not one of: %s", n.Value, strings.Join(s.Enum, ", "))
+		}
+	}
+	if len(s.Pattern) != 0 {
+		if re, err := v.pattern(s); err != nil {
+			v.errorf(n, path, "the schema has an invalid pattern %s: %v", s.Pattern, err)
+		} else if !re.MatchString(n.Value) {
+			v.errorf(n, path, "%q doesn't match the pattern %s", n.Value, s.Pattern)
+		}
+	}
+	if s.Format == "date-time" {
+		if _, err := time.Parse(time.RFC3339, n.Value); err != nil {
+			v.errorf(n, path, "%q is not an RFC3339 timestamp", n.Value)
+		}
+	}
+	if s.Format == "date" {
+		if _, err := time.Parse("2006-01-02", n.Value); err != nil {
+			v.errorf(n, path, "%q is not a date like 2019-03-12", n.Value)
+		}
+	}
+}
+
+func propertyNames(s *Schema) []string {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func describe(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "an object"
+	case yaml.SequenceNode:
+		return "a list"
+	default:
+		return fmt.Sprintf("%q", n.Value)
+	}
+}
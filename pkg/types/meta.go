@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -66,11 +67,13 @@ func (t *Time) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
-	t.Time = pt.Local()
+	// Keep the UTC offset, for the local time of the meetup to stay the same
+	t.Time = pt
 	return nil
 }
 
-// MarshalJSON implements the json.Marshaler interface.
+// MarshalJSON implements the json.Marshaler interface. The time is formatted as
+// RFC3339 with the UTC offset of its location, e.g. 2019-03-12T18:00:00+01:00.
 func (t Time) MarshalJSON() ([]byte, error) {
 	if t.IsZero() {
 		// Encode unset/nil objects as JSON's "null".
@@ -79,7 +82,15 @@ func (t Time) MarshalJSON() ([]byte, error) {
 	buf := make([]byte, 0, len(time.RFC3339)+2)
 	buf = append(buf, '"')
 	// time cannot contain non escapable JSON characters
-	buf = t.UTC().AppendFormat(buf, time.RFC3339)
+	buf = t.AppendFormat(buf, time.RFC3339)
 	buf = append(buf, '"')
 	return buf, nil
 }
+
+// Date is a calendar date like 2019-03-12
+type Date string
+
+// YYYYMMDD returns the date in the format of the keys of the meetups, e.g. 20190312
+func (d Date) YYYYMMDD() string {
+	return strings.Replace(string(d), "-", "", -1)
+}
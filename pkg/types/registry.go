@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Registry owns the companies and speakers of a configuration and resolves the
+// CompanyRef and SpeakerRef references to them. All configuration files are
+// first parsed, and the references are resolved in a second pass, so the order
+// in which the files are read doesn't matter. Any number of registries can be
+// used in the same process.
+type Registry struct {
+	companies map[CompanyID]*Company
+	speakers  map[SpeakerID]*Speaker
+}
+
+// NewRegistry returns an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		companies: map[CompanyID]*Company{},
+		speakers:  map[SpeakerID]*Speaker{},
+	}
+}
+
+// AddCompanies registers the companies read from file. The registry keeps
+// pointers into the slice, so it must not be reallocated afterwards. If a
+// company ID is used twice, the first company wins.
+func (r *Registry) AddCompanies(file string, companies []Company) ValidationErrors {
+	errs := ValidationErrors{}
+	for i := range companies {
+		c := &companies[i]
+		if _, ok := r.companies[c.ID]; ok {
+			errs = append(errs, ValidationError{File: file, Path: fmt.Sprintf("[%d].id", i), Kind: ValidationErrorDuplicateCompany, ID: string(c.ID)})
+			continue
+		}
+		r.companies[c.ID] = c
+	}
+	return errs
+}
+
+// AddSpeakers registers the speakers read from file. The registry keeps
+// pointers into the slice, so it must not be reallocated afterwards. If a
+// speaker ID is used twice, the first speaker wins.
+func (r *Registry) AddSpeakers(file string, speakers []Speaker) ValidationErrors {
+	errs := ValidationErrors{}
+	for i := range speakers {
+		s := &speakers[i]
+		if _, ok := r.speakers[s.ID]; ok {
+			errs = append(errs, ValidationError{File: file, Path: fmt.Sprintf("[%d].id", i), Kind: ValidationErrorDuplicateSpeaker, ID: string(s.ID)})
+			continue
+		}
+		r.speakers[s.ID] = s
+	}
+	return errs
+}
+
+// Company returns the registered company with the given ID, if any
+func (r *Registry) Company(id CompanyID) (*Company, bool) {
+	c, ok := r.companies[id]
+	return c, ok
+}
+
+// Speaker returns the registered speaker with the given ID, if any
+func (r *Registry) Speaker(id SpeakerID) (*Speaker, bool) {
+	s, ok := r.speakers[id]
+	return s, ok
+}
+
+// ResolveSpeakers resolves the company references of the speakers read from file
+func (r *Registry) ResolveSpeakers(file string, speakers []Speaker) ValidationErrors {
+	errs := ValidationErrors{}
+	for i := range speakers {
+		s := &speakers[i]
+		if len(s.Company.id) == 0 {
+			log.Warnf("Speaker %q doesn't have a company", s.ID)
+		}
+		errs = append(errs, r.resolveCompany(&s.Company, file, fmt.Sprintf("[%d].company", i))...)
+	}
+	return errs
+}
+
+// ResolveMeetupGroup resolves all company and speaker references in the meetup group read from file,
+// and checks the IDs of the presentations and the roles of the sponsors. The IDs of the presentations
+// must not be plain numbers, as they would clash with the positions of the presentations without an ID.
+func (r *Registry) ResolveMeetupGroup(file string, mg *MeetupGroup) ValidationErrors {
+	errs := ValidationErrors{}
+	for i := range mg.Organizers {
+		errs = append(errs, r.resolveSpeaker(&mg.Organizers[i], file, fmt.Sprintf("organizers[%d]", i))...)
+	}
+	for i := range mg.EcosystemMembers {
+		errs = append(errs, r.resolveCompany(&mg.EcosystemMembers[i], file, fmt.Sprintf("ecosystemMembers[%d]", i))...)
+	}
+	keys := make([]string, 0, len(mg.Meetups))
+	for key := range mg.Meetups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	// The slices of the Meetup values are shared with the map, so the references can be resolved in place
+	for _, key := range keys {
+		m := mg.Meetups[key]
+		for i := range m.Sponsors {
+			s := &m.Sponsors[i]
+			if _, ok := ValidSponsorRoles[s.Role]; !ok {
+				errs = append(errs, ValidationError{File: file, Path: fmt.Sprintf("meetups.%s.sponsors[%d].role", key, i), Kind: ValidationErrorUnknownSponsorRole, ID: string(s.Role)})
+			}
+			errs = append(errs, r.resolveCompany(&s.Company, file, fmt.Sprintf("meetups.%s.sponsors[%d].company", key, i))...)
+		}
+		ids := map[string]bool{}
+		for i := range m.Presentations {
+			p := &m.Presentations[i]
+			if len(p.ID) != 0 {
+				// The presentations without an ID are identified by their position, e.g. "2"
+				if _, err := strconv.Atoi(p.ID); err == nil {
+					errs = append(errs, ValidationError{File: file, Path: fmt.Sprintf("meetups.%s.presentations[%d].id", key, i), Kind: ValidationErrorNumericPresentation, ID: p.ID})
+				}
+				if ids[p.ID] {
+					errs = append(errs, ValidationError{File: file, Path: fmt.Sprintf("meetups.%s.presentations[%d].id", key, i), Kind: ValidationErrorDuplicatePresentation, ID: p.ID})
+				}
+				ids[p.ID] = true
+			}
+			for j := range p.Speakers {
+				errs = append(errs, r.resolveSpeaker(&p.Speakers[j], file, fmt.Sprintf("meetups.%s.presentations[%d].speakers[%d]", key, i, j))...)
+			}
+		}
+	}
+	return errs
+}
+
+func (r *Registry) resolveCompany(ref *CompanyRef, file, path string) ValidationErrors {
+	if len(ref.id) == 0 {
+		return nil
+	}
+	c, ok := r.companies[ref.id]
+	if !ok {
+		return ValidationErrors{{File: file, Path: path, Kind: ValidationErrorUnknownCompany, ID: string(ref.id)}}
+	}
+	ref.Company = c
+	return nil
+}
+
+func (r *Registry) resolveSpeaker(ref *SpeakerRef, file, path string) ValidationErrors {
+	if len(ref.id) == 0 {
+		return nil
+	}
+	s, ok := r.speakers[ref.id]
+	if !ok {
+		return ValidationErrors{{File: file, Path: path, Kind: ValidationErrorUnknownSpeaker, ID: string(ref.id)}}
+	}
+	ref.Speaker = s
+	return nil
+}
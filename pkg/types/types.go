@@ -6,13 +6,9 @@ import (
 	"sort"
 	"strings"
 	"time"
-
-	log "github.com/sirupsen/logrus"
 )
 
 var (
-	globalSpeakerMap        = map[SpeakerID]*Speaker{}
-	globalCompanyMap        = map[CompanyID]*Company{}
 	ShouldMarshalAutoMeetup = false
 )
 
@@ -23,6 +19,71 @@ type StatsFile struct {
 	MeetupGroups uint64                 `json:"meetupGroups"`
 	AllMeetups   MeetupStats            `json:"allMeetups"`
 	PerMeetup    map[string]MeetupStats `json:"perMeetup"`
+	// Series holds the statistics per month and per year
+	Series map[StatsPeriod]StatsSeries `json:"series,omitempty"`
+	// SpeakersByPeriod holds the speaker statistics per year and per quarter
+	SpeakersByPeriod map[StatsPeriod][]PeriodSpeakerStats `json:"speakersByPeriod,omitempty"`
+}
+
+// StatsSeries holds the statistics of all meetup groups and of each of them per period, the oldest first.
+// The periods are the same for all meetup groups, from the first meetup of any group to now.
+type StatsSeries struct {
+	AllMeetups []SeriesPoint            `json:"allMeetups"`
+	PerMeetup  map[string][]SeriesPoint `json:"perMeetup"`
+}
+
+// SeriesPoint holds the statistics of the meetups in a period, e.g. 2019-03 or 2019
+type SeriesPoint struct {
+	Period  string `json:"period"`
+	Meetups uint64 `json:"meetups"`
+	RSVPs   uint64 `json:"rsvps"`
+	// NewAttendees is the amount of members that RSVPed for the first time
+	NewAttendees uint64 `json:"newAttendees"`
+	// NewSpeakers is the amount of speakers that presented for the first time
+	NewSpeakers uint64 `json:"newSpeakers"`
+	// NewSponsors is the amount of companies that sponsored a meetup for the first time
+	NewSponsors uint64 `json:"newSponsors"`
+	// Retention is the share of the attendees that RSVPed again within 12 months. It isn't
+	// set until 12 months have passed since the end of the period.
+	Retention *float64 `json:"retention,omitempty"`
+	// YearOverYear compares the period with the same period a year earlier
+	YearOverYear *SeriesTrend `json:"yearOverYear,omitempty"`
+}
+
+// SeriesTrend holds the relative changes compared to an earlier period, e.g. 0.5 for 50% more.
+// A change isn't set when the value of the earlier period is zero.
+type SeriesTrend struct {
+	Meetups      *float64 `json:"meetups,omitempty"`
+	RSVPs        *float64 `json:"rsvps,omitempty"`
+	NewAttendees *float64 `json:"newAttendees,omitempty"`
+	NewSpeakers  *float64 `json:"newSpeakers,omitempty"`
+	NewSponsors  *float64 `json:"newSponsors,omitempty"`
+}
+
+// SpeakerStats are the statistics about the speakers presenting at the meetups, to follow
+// how well new speakers are found
+type SpeakerStats struct {
+	Speakers uint64 `json:"speakers"`
+	// FirstTimeSpeakers gave their first public talk ever, i.e. the speakers marked as firstTimeSpeaker
+	// or with the date of the meetup as firstTalk, at their first presentation at any of the meetups
+	FirstTimeSpeakers     uint64  `json:"firstTimeSpeakers"`
+	FirstTimeSpeakerShare float64 `json:"firstTimeSpeakerShare"`
+	// RepeatSpeakers had presented at any of the meetups before
+	RepeatSpeakers     uint64  `json:"repeatSpeakers"`
+	RepeatSpeakerRatio float64 `json:"repeatSpeakerRatio"`
+	// SpeakerProviderSpeakers work for a company with the SpeakerProvider tier in the meetup group,
+	// SponsorSpeakers for a company with another tier, e.g. a sponsor or an organizer's employer,
+	// and IndependentSpeakers for no company
+	SpeakerProviderSpeakers uint64 `json:"speakerProviderSpeakers"`
+	SponsorSpeakers         uint64 `json:"sponsorSpeakers"`
+	IndependentSpeakers     uint64 `json:"independentSpeakers"`
+}
+
+// PeriodSpeakerStats are the speaker statistics of the meetups in a period, e.g. 2019 or 2019-Q1
+type PeriodSpeakerStats struct {
+	Period     string                  `json:"period"`
+	AllMeetups SpeakerStats            `json:"allMeetups"`
+	PerMeetup  map[string]SpeakerStats `json:"perMeetup"`
 }
 
 type MeetupStats struct {
@@ -34,6 +95,61 @@ type MeetupStats struct {
 	TotalRSVPs    uint64                 `json:"totalRSVPs"`
 	AverageRSVPs  uint64                 `json:"averageRSVPs"`
 	UniqueRSVPs   uint64                 `json:"uniqueRSVPs"`
+	SpeakerStats  *SpeakerStats          `json:"speakerStats,omitempty"`
+}
+
+// StatsPeriod is the length of the periods statistics are aggregated for
+type StatsPeriod string
+
+const (
+	StatsPeriodYear    StatsPeriod = "year"
+	StatsPeriodQuarter StatsPeriod = "quarter"
+	StatsPeriodMonth   StatsPeriod = "month"
+)
+
+// Start returns the start of the period t is in, in UTC
+func (p StatsPeriod) Start(t time.Time) time.Time {
+	t = t.UTC()
+	switch p {
+	case StatsPeriodQuarter:
+		return time.Date(t.Year(), (t.Month()-1)/3*3+1, 1, 0, 0, 0, 0, time.UTC)
+	case StatsPeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// End returns the end of the period starting at start, i.e. the start of the next one
+func (p StatsPeriod) End(start time.Time) time.Time {
+	switch p {
+	case StatsPeriodQuarter:
+		return start.AddDate(0, 3, 0)
+	case StatsPeriodMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(1, 0, 0)
+}
+
+// Name returns the name of the period starting at start, e.g. 2019, 2019-Q1 or 2019-01
+func (p StatsPeriod) Name(start time.Time) string {
+	switch p {
+	case StatsPeriodQuarter:
+		return fmt.Sprintf("%d-Q%d", start.Year(), (start.Month()-1)/3+1)
+	case StatsPeriodMonth:
+		return start.Format("2006-01")
+	}
+	return fmt.Sprintf("%d", start.Year())
+}
+
+// PeriodStats are the statistics of the meetups in a period, e.g. 2019 or 2019-Q1.
+// The members and sponsor tiers aren't known per period, and the sponsors are
+// the companies sponsoring the meetups in the period.
+type PeriodStats struct {
+	Period     string                 `json:"period"`
+	Start      time.Time              `json:"start"`
+	End        time.Time              `json:"end"`
+	AllMeetups MeetupStats            `json:"allMeetups"`
+	PerMeetup  map[string]MeetupStats `json:"perMeetup"`
 }
 
 type Config struct {
@@ -67,14 +183,12 @@ var (
 	}
 )
 
+// UnmarshalJSON accepts any role, unknown roles are reported when resolving the meetup group, see Registry
 func (c *SponsorRole) UnmarshalJSON(b []byte) error {
 	str := ""
 	if err := json.Unmarshal(b, &str); err != nil {
 		return err
 	}
-	if _, ok := ValidSponsorRoles[SponsorRole(str)]; !ok {
-		return fmt.Errorf("not a valid sponsor role: %q", str)
-	}
 	*c = SponsorRole(str)
 	return nil
 }
@@ -93,8 +207,8 @@ type Company struct {
 }
 
 type companyInternal struct {
-	ID         CompanyID `json:"id"`
-	Name       string    `json:"name"`
+	ID         CompanyID `json:"id" jsonschema:"required"`
+	Name       string    `json:"name" jsonschema:"required"`
 	WebsiteURL string    `json:"websiteURL"`
 	LogoURL    string    `json:"logoURL"`
 	WhiteLogo  bool      `json:"whiteLogo,omitempty"`
@@ -106,24 +220,33 @@ func (c *Company) UnmarshalJSON(b []byte) error {
 		return fmt.Errorf("couldn't marshal company %q: %v", string(b), err)
 	}
 	c.companyInternal = ctest
-	if _, ok := globalCompanyMap[c.ID]; ok {
-		log.Fatalf("Duplicate company found: %q", c.ID)
-	}
-	globalCompanyMap[c.ID] = c
 	return nil
 }
 
+// CompanyRef is a reference to a company by ID. The reference is resolved by
+// a Registry after all configuration files have been read.
 type CompanyRef struct {
 	*Company `json:"-"`
+
+	// id is the referenced ID, Company is nil until the reference is resolved
+	id CompanyID
 }
 
 func (c CompanyRef) MarshalJSON() ([]byte, error) {
 	if c.Company == nil {
-		return []byte(`""`), nil
+		return json.Marshal(c.id)
 	}
 	return []byte(`"` + c.ID + `"`), nil
 }
 
+// RefID returns the referenced company ID, also if the reference couldn't be resolved
+func (c CompanyRef) RefID() CompanyID {
+	if c.Company != nil {
+		return c.ID
+	}
+	return c.id
+}
+
 func (c *CompanyRef) UnmarshalJSON(b []byte) error {
 	if string(b) == "null" || string(b) == `""` {
 		*c = CompanyRef{}
@@ -134,11 +257,7 @@ func (c *CompanyRef) UnmarshalJSON(b []byte) error {
 		return fmt.Errorf("couldn't marshal company %q: %v", string(b), err)
 	}
 
-	company, ok := globalCompanyMap[cid]
-	if !ok {
-		log.Fatalf("Company reference not found %q: %q", cid, string(b))
-	}
-	*c = CompanyRef{company}
+	*c = CompanyRef{id: cid}
 	return nil
 }
 
@@ -147,14 +266,22 @@ type Speaker struct {
 }
 
 type speakerInternal struct {
-	ID             SpeakerID  `json:"id"`
-	Name           string     `json:"name"`
+	ID             SpeakerID  `json:"id" jsonschema:"required"`
+	Name           string     `json:"name" jsonschema:"required"`
 	Title          string     `json:"title,omitempty"`
 	Email          string     `json:"email"`
 	Company        CompanyRef `json:"company"`
 	Github         string     `json:"github"`
 	Twitter        string     `json:"twitter,omitempty"`
 	SpeakersBureau string     `json:"speakersBureau"`
+	// FirstTalk is the date of the speaker's first public talk anywhere, if known
+	FirstTalk Date `json:"firstTalk,omitempty"`
+	// FirstTimeSpeaker marks a speaker whose first public talk was at one of the meetups
+	FirstTimeSpeaker bool `json:"firstTimeSpeaker,omitempty"`
+	// Pronouns are the pronouns the speaker declared, e.g. she/her
+	Pronouns string `json:"pronouns,omitempty"`
+	// Location is where the speaker is based, e.g. a city
+	Location string `json:"location,omitempty"`
 }
 
 func (s *Speaker) UnmarshalJSON(b []byte) error {
@@ -163,13 +290,6 @@ func (s *Speaker) UnmarshalJSON(b []byte) error {
 		return fmt.Errorf("couldn't marshal speaker %q: %v", string(b), err)
 	}
 	s.speakerInternal = stest
-	if _, ok := globalSpeakerMap[s.ID]; ok {
-		log.Fatalf("Duplicate speaker found: %q", s.ID)
-	}
-	if s.Company.Company == nil {
-		log.Warnf("Speaker %q doesn't have a company", s.ID)
-	}
-	globalSpeakerMap[s.ID] = s
 	return nil
 }
 
@@ -190,17 +310,30 @@ func (s Speaker) String() string {
 	return str
 }
 
+// SpeakerRef is a reference to a speaker by ID. The reference is resolved by
+// a Registry after all configuration files have been read.
 type SpeakerRef struct {
 	*Speaker `json:"-"`
+
+	// id is the referenced ID, Speaker is nil until the reference is resolved
+	id SpeakerID
 }
 
 func (s SpeakerRef) MarshalJSON() ([]byte, error) {
 	if s.Speaker == nil {
-		return []byte(`""`), nil
+		return json.Marshal(s.id)
 	}
 	return []byte(`"` + s.ID + `"`), nil
 }
 
+// RefID returns the referenced speaker ID, also if the reference couldn't be resolved
+func (s SpeakerRef) RefID() SpeakerID {
+	if s.Speaker != nil {
+		return s.ID
+	}
+	return s.id
+}
+
 func (s *SpeakerRef) UnmarshalJSON(b []byte) error {
 	if string(b) == "null" || string(b) == `""` {
 		*s = SpeakerRef{}
@@ -210,11 +343,7 @@ func (s *SpeakerRef) UnmarshalJSON(b []byte) error {
 	if err := json.Unmarshal(b, &sid); err != nil {
 		return fmt.Errorf("couldn't marshal speaker %q: %v", string(b), err)
 	}
-	speaker, ok := globalSpeakerMap[sid]
-	if !ok {
-		log.Fatalf("Speaker reference not found %q: %q", sid, string(b))
-	}
-	*s = SpeakerRef{speaker}
+	*s = SpeakerRef{id: sid}
 	return nil
 }
 
@@ -225,15 +354,16 @@ type AutogenMeetupGroup struct {
 	Country      string                    `json:"country"`
 	Description  string                    `json:"description"`
 	SponsorTiers map[CompanyID]SponsorTier `json:"sponsorTiers"`
-	AutoMeetups  map[string]AutogenMeetup  `json:"-"`
+	AutoMeetups  []AutogenMeetup           `json:"-"`
 
 	Members uint64 `json:"-"`
 }
 
 type MeetupGroup struct {
-	*AutogenMeetupGroup `json:",inline,omitempty"`
+	*AutogenMeetupGroup `json:",inline,omitempty" jsonschema:"-"`
 
-	MeetupID          string            `json:"meetupID"`
+	MeetupID          string            `json:"meetupID" jsonschema:"required"`
+	Source            *EventSourceSpec  `json:"source,omitempty"`
 	Organizers        []SpeakerRef      `json:"organizers"`
 	IgnoreMeetupDates []string          `json:"ignoreMeetupDates,omitempty"`
 	CFP               string            `json:"cfpLink"`
@@ -242,27 +372,150 @@ type MeetupGroup struct {
 	EcosystemMembers  []CompanyRef      `json:"ecosystemMembers"`
 	Meetups           map[string]Meetup `json:"meetups"`
 	MeetupList        MeetupList        `json:"-"`
+	// TimeZone is the IANA time zone of the meetups, e.g. Europe/Helsinki. It defaults to the
+	// time zone of the meetup group's country.
+	TimeZone string `json:"timeZone,omitempty"`
+	// IgnoreEvents are the IDs of the fetched events that aren't meetups of the group. It replaces
+	// IgnoreMeetupDates, which ignores all events on the given dates.
+	IgnoreEvents []uint64 `json:"ignoreEvents,omitempty"`
+	// File is the meetup.yaml file the group was read from
+	File string `json:"-"`
+	// Unmatched lists the meetups and events ApplyGeneratedData couldn't match
+	Unmatched MatchReport `json:"-"`
+}
+
+// MatchReport lists the meetups in meetup.yaml and the fetched events that couldn't be matched
+type MatchReport struct {
+	// Meetups are the meetups in meetup.yaml without a fetched event
+	Meetups []UnmatchedMeetup
+	// Events are the fetched events that aren't in meetup.yaml, and aren't ignored
+	Events []AutogenMeetup
 }
 
+// UnmatchedMeetup is a meetup in meetup.yaml without a fetched event
+type UnmatchedMeetup struct {
+	Key    string
+	Reason string
+}
+
+type EventSourceType string
+
+var (
+	EventSourceMeetupDotCom EventSourceType = "meetup.com"
+	// EventSourceMeetupDotComREST uses the retired REST API of meetup.com, which doesn't require credentials
+	EventSourceMeetupDotComREST EventSourceType = "meetup.com-rest"
+	EventSourceFile             EventSourceType = "file"
+)
+
+// EventSourceSpec describes where the autogenerated information about a meetup group is fetched from
+type EventSourceSpec struct {
+	// Type is the kind of source to use, defaults to meetup.com
+	Type EventSourceType `json:"type" jsonschema:"required"`
+	// Path points to the directory with cached JSON files for the file source,
+	// relative to the directory of the meetup.yaml file
+	Path string `json:"path,omitempty"`
+}
+
+// IsIgnored tells whether the event is ignored by its ID, or by its date through the deprecated IgnoreMeetupDates
+func (mg *MeetupGroup) IsIgnored(ev *AutogenMeetup) bool {
+	for _, id := range mg.IgnoreEvents {
+		if id == ev.ID {
+			return true
+		}
+	}
+	for _, date := range mg.IgnoreMeetupDates {
+		if date == ev.Date.YYYYMMDD() {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyGeneratedData matches the fetched events to the meetups in meetup.yaml. A meetup with an
+// eventID is matched to that event. The other meetups are matched by the date their key starts
+// with, if there's only one unmatched event and one unmatched meetup on that date. What couldn't
+// be matched is listed in Unmatched.
 func (mg *MeetupGroup) ApplyGeneratedData() {
-	for key := range mg.AutoMeetups {
-		m, ok := mg.Meetups[key]
+	mg.Unmatched = MatchReport{}
+	keys := make([]string, 0, len(mg.Meetups))
+	for key := range mg.Meetups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	events := map[uint64]int{}
+	for i, ev := range mg.AutoMeetups {
+		events[ev.ID] = i
+	}
+	matched := map[int]bool{}
+	byDate := []string{}
+	for _, key := range keys {
+		m := mg.Meetups[key]
+		if m.EventID == 0 {
+			byDate = append(byDate, key)
+			continue
+		}
+		i, ok := events[m.EventID]
 		if !ok {
-			found := false
-			for _, date := range mg.IgnoreMeetupDates {
-				if key == date {
-					found = true
-				}
-			}
-			if !found {
-				log.Warnf("Didn't find information about meetup at %s on date %q\n", mg.Name, key)
-			}
+			mg.Unmatched.Meetups = append(mg.Unmatched.Meetups, UnmatchedMeetup{Key: key, Reason: fmt.Sprintf("event %d wasn't found, or is ignored", m.EventID)})
+			continue
+		}
+		if matched[i] {
+			mg.Unmatched.Meetups = append(mg.Unmatched.Meetups, UnmatchedMeetup{Key: key, Reason: fmt.Sprintf("event %d is already matched to another meetup", m.EventID)})
 			continue
 		}
-		autoMeetup := mg.AutoMeetups[key]
-		m.AutogenMeetup = &autoMeetup
-		mg.Meetups[key] = m
+		mg.applyEvent(key, i)
+		matched[i] = true
 	}
+
+	eventsOnDate := map[string][]int{}
+	for i, ev := range mg.AutoMeetups {
+		if !matched[i] {
+			date := ev.Date.YYYYMMDD()
+			eventsOnDate[date] = append(eventsOnDate[date], i)
+		}
+	}
+	meetupsOnDate := map[string]int{}
+	for _, key := range byDate {
+		meetupsOnDate[MeetupKeyDate(key)]++
+	}
+	for _, key := range byDate {
+		date := MeetupKeyDate(key)
+		switch candidates := eventsOnDate[date]; {
+		case len(candidates) == 0:
+			mg.Unmatched.Meetups = append(mg.Unmatched.Meetups, UnmatchedMeetup{Key: key, Reason: fmt.Sprintf("there's no event on %s", date)})
+		case len(candidates) > 1 || meetupsOnDate[date] > 1:
+			mg.Unmatched.Meetups = append(mg.Unmatched.Meetups, UnmatchedMeetup{Key: key, Reason: fmt.Sprintf("there are several events or meetups on %s, set eventID", date)})
+		default:
+			mg.applyEvent(key, candidates[0])
+			matched[candidates[0]] = true
+		}
+	}
+
+	for i, ev := range mg.AutoMeetups {
+		if !matched[i] {
+			mg.Unmatched.Events = append(mg.Unmatched.Events, ev)
+		}
+	}
+	sort.Slice(mg.Unmatched.Events, func(i, j int) bool {
+		return mg.Unmatched.Events[i].Date.Before(mg.Unmatched.Events[j].Date.Time)
+	})
+}
+
+func (mg *MeetupGroup) applyEvent(key string, i int) {
+	m := mg.Meetups[key]
+	autoMeetup := mg.AutoMeetups[i]
+	m.AutogenMeetup = &autoMeetup
+	mg.Meetups[key] = m
+}
+
+// MeetupKeyDate returns the date a meetup's key starts with, e.g. 20190312 for 20190312 or
+// 20190312-workshop
+func MeetupKeyDate(key string) string {
+	if i := strings.IndexByte(key, '-'); i >= 0 {
+		return key[:i]
+	}
+	return key
 }
 
 // CityLowercase gets the lowercase variant of the city
@@ -270,9 +523,14 @@ func (mg *MeetupGroup) CityLowercase() string {
 	return strings.ToLower(mg.City)
 }
 
+// SetMeetupList sets MeetupList to the meetups sorted by date, the latest first. The meetups
+// without a fetched event are left out, as they don't have a date.
 func (mg *MeetupGroup) SetMeetupList() {
 	marr := []Meetup{}
 	for _, m := range mg.Meetups {
+		if m.AutogenMeetup == nil {
+			continue
+		}
 		marr = append(marr, m)
 	}
 	mg.MeetupList = MeetupList(marr)
@@ -310,13 +568,15 @@ type AutogenMeetup struct {
 }
 
 type HumanMeetup struct {
+	// EventID is the ID of the meetup.com event, for matching the meetup to it instead of by date
+	EventID       uint64          `json:"eventID,omitempty"`
 	Recording     string          `json:"recording"`
 	Sponsors      []MeetupSponsor `json:"sponsors"`
 	Presentations []Presentation  `json:"presentations"`
 }
 
 type Meetup struct {
-	*AutogenMeetup `json:",inline,omitempty"`
+	*AutogenMeetup `json:",inline,omitempty" jsonschema:"-"`
 	HumanMeetup    `json:",inline"`
 }
 
@@ -336,12 +596,13 @@ func (m Meetup) MarshalJSON() ([]byte, error) {
 }
 
 type MeetupSponsor struct {
-	Role    SponsorRole `json:"role"`
-	Company CompanyRef  `json:"company"`
+	Role    SponsorRole `json:"role" jsonschema:"required"`
+	Company CompanyRef  `json:"company" jsonschema:"required"`
 }
 
+// DateTime returns the date and time of the meetup in the time zone of the meetup group
 func (m *Meetup) DateTime() string {
-	d := m.Date.UTC()
+	d := m.Date.Time
 	year, month, day := d.Date()
 	hour, min, _ := d.Clock()
 	hour2, min2, _ := d.Add(m.Duration.Duration).Clock()
@@ -349,9 +610,12 @@ func (m *Meetup) DateTime() string {
 }
 
 type Presentation struct {
-	Duration  Duration     `json:"duration"`
+	// ID optionally identifies the presentation within the meetup, for links to it
+	// to keep working when the presentations are reordered
+	ID        string       `json:"id,omitempty"`
+	Duration  Duration     `json:"duration" jsonschema:"required"`
 	Delay     *Duration    `json:"delay,omitempty"`
-	Title     string       `json:"title"`
+	Title     string       `json:"title" jsonschema:"required"`
 	Slides    string       `json:"slides"`
 	Recording string       `json:"recording,omitempty"`
 	Speakers  []SpeakerRef `json:"speakers"`
@@ -360,10 +624,12 @@ type Presentation struct {
 	End   time.Time `json:"-"`
 }
 
+// StartTime returns the start of the presentation in the time zone of the meetup group
 func (p *Presentation) StartTime() string {
-	return fmt.Sprintf("%d:%02d", p.Start.UTC().Hour(), p.Start.UTC().Minute())
+	return fmt.Sprintf("%d:%02d", p.Start.Hour(), p.Start.Minute())
 }
 
+// EndTime returns the end of the presentation in the time zone of the meetup group
 func (p *Presentation) EndTime() string {
-	return fmt.Sprintf("%d:%02d", p.End.UTC().Hour(), p.End.UTC().Minute())
+	return fmt.Sprintf("%d:%02d", p.End.Hour(), p.End.Minute())
 }
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ValidationErrorKind string
+
+var (
+	ValidationErrorParse                 ValidationErrorKind = "Parse"
+	ValidationErrorDuplicateCompany      ValidationErrorKind = "DuplicateCompany"
+	ValidationErrorDuplicateSpeaker      ValidationErrorKind = "DuplicateSpeaker"
+	ValidationErrorDuplicatePresentation ValidationErrorKind = "DuplicatePresentation"
+	ValidationErrorNumericPresentation   ValidationErrorKind = "NumericPresentation"
+	ValidationErrorUnknownCompany        ValidationErrorKind = "UnknownCompany"
+	ValidationErrorUnknownSpeaker        ValidationErrorKind = "UnknownSpeaker"
+	ValidationErrorUnknownSponsorRole    ValidationErrorKind = "UnknownSponsorRole"
+	ValidationErrorInvalidSource         ValidationErrorKind = "InvalidSource"
+	ValidationErrorInvalidTimeZone       ValidationErrorKind = "InvalidTimeZone"
+	ValidationErrorSchema                ValidationErrorKind = "Schema"
+)
+
+// ValidationError describes a problem found in one of the configuration files
+type ValidationError struct {
+	// File is the configuration file the problem was found in
+	File string `json:"file"`
+	// Line and Column point to the offending value in the file, if known
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+	// Path points to the offending value in the YAML document, e.g. "meetups.20190312.presentations[0].speakers[1]"
+	Path string `json:"path,omitempty"`
+	// Kind describes what kind of problem this is
+	Kind ValidationErrorKind `json:"kind"`
+	// ID is the offending company or speaker ID, if any
+	ID string `json:"id,omitempty"`
+	// Message holds further details, e.g. the error from the YAML parser
+	Message string `json:"message,omitempty"`
+}
+
+func (e ValidationError) Error() string {
+	str := e.File
+	if e.Line != 0 {
+		str += fmt.Sprintf(":%d:%d", e.Line, e.Column)
+	}
+	if len(e.Path) != 0 {
+		str += ": " + e.Path
+	}
+	str += ": " + string(e.Kind)
+	if len(e.ID) != 0 {
+		str += fmt.Sprintf(" %q", e.ID)
+	}
+	if len(e.Message) != 0 {
+		str += ": " + e.Message
+	}
+	return str
+}
+
+// ValidationErrors is a list of all problems found in the configuration
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		msgs = append(msgs, e.Error())
+	}
+	return fmt.Sprintf("found %d problem(s) in the configuration:\n%s", len(errs), strings.Join(msgs, "\n"))
+}